@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+
+	"battleships/game"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+var errInputEnded = errors.New("input ended before the game finished")
+
+type console struct {
+	in  *bufio.Scanner
+	out io.Writer
+	rng *rand.Rand
+}
+
+func newConsole(in io.Reader, out io.Writer, rng *rand.Rand) *console {
+	return &console{in: bufio.NewScanner(in), out: out, rng: rng}
+}
+
+func rulesFromFlags(name string, salvo bool, shootAgain bool) (game.Rules, error) {
+	var rules game.Rules
+	switch strings.ToLower(name) {
+	case "default":
+		rules = game.DefaultRules
+	case "classic":
+		rules = game.ClassicRules
+	case "tournament":
+		rules = game.TournamentRules
+	case "morskoi":
+		rules = game.MorskoiBoiRules
+	default:
+		return rules, fmt.Errorf("unknown rules %q, want default, classic, tournament or morskoi", name)
+	}
+	if salvo {
+		rules.Mode = game.ModeSalvo
+	}
+	if shootAgain {
+		rules.TurnPolicy = game.TurnPolicyShootAgainOnHit
+	}
+	return rules, nil
+}
+
+type seat interface {
+	placeFleet(battle *game.Game, player int) error
+	takeTurn(battle *game.Game, player int) error
+}
+
+// play runs a game to the end. computerPlayer is the player the computer
+// controls at the given difficulty, or 0 when two people share the terminal.
+func play(console *console, rules game.Rules, computerPlayer int, difficulty game.Difficulty) error {
+	battle, gameErr := game.NewGame(rules)
+	if gameErr != nil {
+		return gameErr
+	}
+
+	hotSeat := computerPlayer == 0
+	seats := [2]seat{console, console}
+	if !hotSeat {
+		opponent, opponentErr := newComputer(console.out, console.rng, difficulty)
+		if opponentErr != nil {
+			return opponentErr
+		}
+		seats[computerPlayer-1] = opponent
+	}
+
+	for player := 1; player <= 2; player++ {
+		if hotSeat {
+			handErr := console.handOver(player)
+			if handErr != nil {
+				return handErr
+			}
+		}
+		placeErr := seats[player-1].placeFleet(battle, player)
+		if placeErr != nil {
+			return placeErr
+		}
+	}
+
+	startErr := battle.StartBattle()
+	if startErr != nil {
+		return startErr
+	}
+
+	for battle.Phase() == game.PhaseBattle {
+		player := battle.CurrentPlayer()
+		if hotSeat {
+			handErr := console.handOver(player)
+			if handErr != nil {
+				return handErr
+			}
+		}
+		for battle.Phase() == game.PhaseBattle && battle.CurrentPlayer() == player {
+			turnErr := seats[player-1].takeTurn(battle, player)
+			if turnErr != nil {
+				return turnErr
+			}
+		}
+		if hotSeat && battle.Phase() == game.PhaseBattle {
+			_, readErr := console.readLine("Press Enter to end your turn.")
+			if readErr != nil {
+				return readErr
+			}
+		}
+	}
+
+	console.announceWinner(battle, computerPlayer)
+	return nil
+}
+
+func (console *console) readLine(prompt string) (string, error) {
+	fmt.Fprint(console.out, prompt)
+	if !console.in.Scan() {
+		fmt.Fprintln(console.out)
+		if scanErr := console.in.Err(); scanErr != nil {
+			return "", scanErr
+		}
+		return "", errInputEnded
+	}
+	return strings.TrimSpace(console.in.Text()), nil
+}
+
+// handOver clears the screen on both sides of the pause so neither player
+// sees the other's boards while the seat changes.
+func (console *console) handOver(player int) error {
+	fmt.Fprint(console.out, clearScreen)
+	_, readErr := console.readLine(fmt.Sprintf("Pass to player %d and press Enter.", player))
+	fmt.Fprint(console.out, clearScreen)
+	return readErr
+}
+
+func (console *console) placeFleet(battle *game.Game, player int) error {
+	for {
+		board, _ := battle.Board(player)
+		class, left := nextShipToPlace(battle.Rules().Fleet, board)
+		if !left {
+			fmt.Fprintf(console.out, "Your fleet:\n%s\n", board)
+			return nil
+		}
+
+		fmt.Fprintf(console.out, "Your fleet:\n%s\n", board)
+		line, readErr := console.readLine(fmt.Sprintf("Player %d, place your %s (%d squares), like B4 across or B4 down, or type random: ", player, class.Name, class.Length))
+		if readErr != nil {
+			return readErr
+		}
+
+		if strings.EqualFold(line, "random") {
+			placeErr := placeRestRandomly(battle, player, console.rng)
+			if placeErr != nil {
+				fmt.Fprintln(console.out, placeErr)
+			}
+			continue
+		}
+
+		coord, orientation, parseErr := parsePlacement(line)
+		if parseErr != nil {
+			fmt.Fprintln(console.out, parseErr)
+			continue
+		}
+		placeErr := battle.PlaceShip(player, class.Name, coord.Row, coord.Col, orientation)
+		if placeErr != nil {
+			fmt.Fprintln(console.out, placeErr)
+		}
+	}
+}
+
+func (console *console) takeTurn(battle *game.Game, player int) error {
+	view, _ := battle.ViewFor(player)
+	fmt.Fprintf(console.out, "Your shots:\n%s\nYour fleet:\n%s\n", view.Tracking, view.Own)
+
+	if battle.Rules().Mode == game.ModeSalvo {
+		shots := shipsAfloat(view.Own)
+		line, readErr := console.readLine(fmt.Sprintf("Player %d, fire %d shots, like A1 B2: ", player, shots))
+		if readErr != nil {
+			return readErr
+		}
+		volley, parseErr := parseCoords(line)
+		if parseErr != nil {
+			fmt.Fprintln(console.out, parseErr)
+			return nil
+		}
+		results, shotErr := battle.CurrentPlayerTakeSalvo(player, volley)
+		if shotErr != nil {
+			fmt.Fprintln(console.out, shotErr)
+			return nil
+		}
+		for _, result := range results {
+			fmt.Fprintln(console.out, describeShot(result))
+		}
+		return nil
+	}
+
+	line, readErr := console.readLine(fmt.Sprintf("Player %d, fire at: ", player))
+	if readErr != nil {
+		return readErr
+	}
+	coord, parseErr := game.ParseCoord(line)
+	if parseErr != nil {
+		fmt.Fprintln(console.out, parseErr)
+		return nil
+	}
+	result, shotErr := battle.CurrentPlayerTakeShot(player, coord.Row, coord.Col)
+	if shotErr != nil {
+		fmt.Fprintln(console.out, shotErr)
+		return nil
+	}
+	fmt.Fprintln(console.out, describeShot(result))
+	return nil
+}
+
+func (console *console) announceWinner(battle *game.Game, computerPlayer int) {
+	fmt.Fprint(console.out, clearScreen)
+	switch {
+	case computerPlayer == 0:
+		fmt.Fprintf(console.out, "Player %d wins!\n", battle.Winner())
+	case battle.Winner() == computerPlayer:
+		fmt.Fprintln(console.out, "The computer wins!")
+	default:
+		fmt.Fprintln(console.out, "You win!")
+	}
+	for player := 1; player <= 2; player++ {
+		board, _ := battle.Board(player)
+		fmt.Fprintf(console.out, "Player %d's fleet:\n%s\n", player, board)
+	}
+}
+
+func describeShot(result game.ShotResult) string {
+	if result.Outcome == game.OutcomeSunk {
+		return fmt.Sprintf("%s: Hit and sunk the %s", result.Coord(), result.SunkShip)
+	}
+	return fmt.Sprintf("%s: %s", result.Coord(), result.Outcome)
+}
+
+func nextShipToPlace(fleet game.FleetSpec, board game.Board) (game.ShipClass, bool) {
+	placed := map[string]int{}
+	for _, ship := range board.Ships() {
+		placed[ship.Class]++
+	}
+	for _, class := range fleet {
+		if placed[class.Name] < class.Count {
+			return class, true
+		}
+	}
+	return game.ShipClass{}, false
+}
+
+func placeRestRandomly(battle *game.Game, player int, rng *rand.Rand) error {
+	board, _ := battle.Board(player)
+	placed := map[string]int{}
+	for _, ship := range board.Ships() {
+		placed[ship.Class]++
+	}
+	var rest game.FleetSpec
+	for _, class := range battle.Rules().Fleet {
+		class.Count -= placed[class.Name]
+		rest = append(rest, class)
+	}
+
+	randomBoard, randomErr := game.PlaceFleetRandomly(board, rest, rng)
+	if randomErr != nil {
+		return randomErr
+	}
+	return battle.PlaceFleet(player, layoutOf(randomBoard.Ships()[len(board.Ships()):]))
+}
+
+func layoutOf(ships []game.Ship) game.FleetLayout {
+	var layout game.FleetLayout
+	for _, ship := range ships {
+		layout = append(layout, game.ShipPlacement{Class: ship.Class, Row: ship.Row, Col: ship.Col, Orientation: ship.Orientation})
+	}
+	return layout
+}
+
+func parsePlacement(line string) (game.Coord, game.Orientation, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || len(fields) > 2 {
+		return game.Coord{}, game.Horizontal, fmt.Errorf("want a square and a direction, like B4 across or B4 down")
+	}
+	coord, coordErr := game.ParseCoord(fields[0])
+	if coordErr != nil {
+		return coord, game.Horizontal, coordErr
+	}
+	if len(fields) == 1 {
+		return coord, game.Horizontal, nil
+	}
+	switch strings.ToLower(fields[1]) {
+	case "across", "a", "horizontal", "h":
+		return coord, game.Horizontal, nil
+	case "down", "d", "vertical", "v":
+		return coord, game.Vertical, nil
+	}
+	return coord, game.Horizontal, fmt.Errorf("unknown direction %q, want across or down", fields[1])
+}
+
+func parseCoords(line string) ([]game.Coord, error) {
+	var coords []game.Coord
+	for _, field := range strings.Fields(strings.ReplaceAll(line, ",", " ")) {
+		coord, coordErr := game.ParseCoord(field)
+		if coordErr != nil {
+			return nil, coordErr
+		}
+		coords = append(coords, coord)
+	}
+	return coords, nil
+}
+
+func shipsAfloat(own game.View) int {
+	afloat := 0
+	for _, class := range own.Fleet() {
+		afloat += class.Count
+	}
+	return afloat - len(own.SunkShips())
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"battleships/game"
+)
+
+var oneBoatRules = game.Rules{Width: 3, Height: 1, Fleet: game.FleetSpec{{Name: "Boat", Length: 1, Count: 1}}}
+
+func TestHotSeatGamePlaysToAWin(t *testing.T) {
+	//Arrange
+	input := strings.Join([]string{
+		"", "A1",
+		"", "B1",
+		"", "Z9", "C1", "",
+		"", "A1",
+	}, "\n") + "\n"
+	var output strings.Builder
+	console := newConsole(strings.NewReader(input), &output, rand.New(rand.NewSource(1)))
+
+	//Act
+	err := play(console, oneBoatRules, 0, game.DifficultyEasy)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	for _, want := range []string{"Z9 is off the grid, want A1 to C1", "C1: Miss", "A1: Hit and sunk the Boat", "Player 2 wins!"} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("output is missing %q", want)
+		}
+	}
+}
+
+func TestScreenIsClearedAroundEveryHandOver(t *testing.T) {
+	//Arrange
+	var output strings.Builder
+	console := newConsole(strings.NewReader("\n"), &output, rand.New(rand.NewSource(1)))
+
+	//Act
+	console.handOver(2)
+
+	//Assert
+	want := clearScreen + "Pass to player 2 and press Enter." + clearScreen
+	if output.String() != want {
+		t.Errorf("got %q, want %q", output.String(), want)
+	}
+}
+
+func TestRandomPlacesTheRestOfTheFleet(t *testing.T) {
+	//Arrange
+	var output strings.Builder
+	console := newConsole(strings.NewReader("A1 down\nrandom\n"), &output, rand.New(rand.NewSource(1)))
+	battle, _ := game.NewGame(game.ClassicRules)
+
+	//Act
+	err := console.placeFleet(battle, 1)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if readyErr := battle.CheckReady(1); readyErr != nil {
+		t.Errorf("got %v, want player 1 ready", readyErr)
+	}
+	board, _ := battle.Board(1)
+	first := board.Ships()[0]
+	if first.Class != "Carrier" || first.Row != 0 || first.Col != 0 || first.Orientation != game.Vertical {
+		t.Errorf("got %s at %s orientation %d, want Carrier at A1 Vertical", first.Class, game.Coord{Row: first.Row, Col: first.Col}, first.Orientation)
+	}
+}
+
+func TestRunningOutOfInputStopsTheGame(t *testing.T) {
+	//Arrange
+	var output strings.Builder
+	console := newConsole(strings.NewReader("\nA1\n"), &output, rand.New(rand.NewSource(1)))
+
+	//Act
+	err := play(console, oneBoatRules, 0, game.DifficultyEasy)
+
+	//Assert
+	if !errors.Is(err, errInputEnded) {
+		t.Errorf("got %v, want %v", err, errInputEnded)
+	}
+}
+
+func TestParsePlacement(t *testing.T) {
+	type placement struct {
+		line        string
+		coord       game.Coord
+		orientation game.Orientation
+		errorText   string
+	}
+	placements := []placement{
+		{line: "B4 across", coord: game.Coord{Row: 3, Col: 1}, orientation: game.Horizontal},
+		{line: "c2 DOWN", coord: game.Coord{Row: 1, Col: 2}, orientation: game.Vertical},
+		{line: "J10", coord: game.Coord{Row: 9, Col: 9}, orientation: game.Horizontal},
+		{line: "B4 sideways", errorText: `unknown direction "sideways", want across or down`},
+		{line: "", errorText: "want a square and a direction, like B4 across or B4 down"},
+	}
+
+	for _, placement := range placements {
+		//act
+		coord, orientation, err := parsePlacement(placement.line)
+
+		//assert
+		if placement.errorText != "" {
+			if err == nil || err.Error() != placement.errorText {
+				t.Errorf("%q: got %v, want %v", placement.line, err, placement.errorText)
+			}
+			continue
+		}
+		if err != nil || coord != placement.coord || orientation != placement.orientation {
+			t.Errorf("%q: got %v %v %v, want %v %v", placement.line, coord, orientation, err, placement.coord, placement.orientation)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+
+	"battleships/game"
+)
+
+// computer places its fleet and lets its strategy choose each shot, through
+// the same Game calls as a human player.
+type computer struct {
+	out        io.Writer
+	rng        *rand.Rand
+	strategy   game.Strategy
+	difficulty game.Difficulty
+}
+
+func newComputer(out io.Writer, rng *rand.Rand, difficulty game.Difficulty) (*computer, error) {
+	strategy, strategyErr := game.NewStrategyForDifficulty(difficulty, rng)
+	if strategyErr != nil {
+		return nil, strategyErr
+	}
+	return &computer{out: out, rng: rng, strategy: strategy, difficulty: difficulty}, nil
+}
+
+// placeFleet hides the fleet from hunting bots from Hard up, and places it at
+// random below that.
+func (computer *computer) placeFleet(battle *game.Game, player int) error {
+	if computer.difficulty < game.DifficultyHard {
+		return placeRestRandomly(battle, player, computer.rng)
+	}
+	board, _ := battle.Board(player)
+	placed, placeErr := game.PlaceFleetSmartly(board, battle.Rules().Fleet, computer.rng)
+	if placeErr != nil {
+		return placeErr
+	}
+	return battle.PlaceFleet(player, layoutOf(placed.Ships()))
+}
+
+func (computer *computer) takeTurn(battle *game.Game, player int) error {
+	view, _ := battle.ViewFor(player)
+
+	if battle.Rules().Mode == game.ModeSalvo {
+		volley := game.NextSalvo(computer.strategy, view.Tracking, shipsAfloat(view.Own))
+		results, shotErr := battle.CurrentPlayerTakeSalvo(player, volley)
+		if shotErr != nil {
+			return shotErr
+		}
+		for _, result := range results {
+			fmt.Fprintf(computer.out, "The computer fires at %s\n", describeShot(result))
+		}
+		return nil
+	}
+
+	shot := computer.strategy.NextShot(view.Tracking)
+	result, shotErr := battle.CurrentPlayerTakeShot(player, shot.Row, shot.Col)
+	if shotErr != nil {
+		return shotErr
+	}
+	fmt.Fprintf(computer.out, "The computer fires at %s\n", describeShot(result))
+	return nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"battleships/game"
+)
+
+func TestGameAgainstTheComputerAnnouncesTheWinner(t *testing.T) {
+	type match struct {
+		name  string
+		input string
+		want  string
+	}
+	// With seed 1 the computer hides its Boat at C1 and, given the chance,
+	// fires first at A1.
+	matches := []match{
+		{name: "human sinks the Boat first", input: "A1\nC1\n", want: "You win!"},
+		{name: "human misses", input: "A1\nA1\n", want: "The computer wins!"},
+	}
+
+	for _, match := range matches {
+		//arrange
+		var output strings.Builder
+		console := newConsole(strings.NewReader(match.input), &output, rand.New(rand.NewSource(1)))
+
+		//act
+		err := play(console, oneBoatRules, 2, game.DifficultyExpert)
+
+		//assert
+		if err != nil {
+			t.Fatalf("%s: got %v, want no error", match.name, err)
+		}
+		if strings.Contains(output.String(), clearScreen+"Pass to player") {
+			t.Errorf("%s: got a hand over, want none against the computer", match.name)
+		}
+		if !strings.Contains(output.String(), match.want) {
+			t.Errorf("%s: got %q, want %q announced", match.name, output.String(), match.want)
+		}
+	}
+}
+
+func TestComputerNeverFiresAtTheSameSquareTwice(t *testing.T) {
+	type variant struct {
+		mode       game.Mode
+		difficulty game.Difficulty
+	}
+	variants := []variant{
+		{mode: game.ModeClassic, difficulty: game.DifficultyEasy},
+		{mode: game.ModeClassic, difficulty: game.DifficultyMedium},
+		{mode: game.ModeSalvo, difficulty: game.DifficultyHard},
+		{mode: game.ModeSalvo, difficulty: game.DifficultyExpert},
+	}
+
+	for _, variant := range variants {
+		//arrange
+		rules := game.ClassicRules
+		rules.Mode = variant.mode
+		battle, _ := game.NewGame(rules)
+		var output strings.Builder
+		var players [2]*computer
+		for player := 1; player <= 2; player++ {
+			players[player-1], _ = newComputer(&output, rand.New(rand.NewSource(int64(player))), variant.difficulty)
+			players[player-1].placeFleet(battle, player)
+		}
+		battle.StartBattle()
+
+		//act
+		for turn := 0; battle.Phase() == game.PhaseBattle && turn < 200; turn++ {
+			player := battle.CurrentPlayer()
+			turnErr := players[player-1].takeTurn(battle, player)
+
+			//assert
+			if turnErr != nil {
+				t.Fatalf("%s mode at %s: got %v, want no error", variant.mode, variant.difficulty, turnErr)
+			}
+		}
+		if battle.Phase() != game.PhaseFinished {
+			t.Errorf("%s mode at %s: got phase %s, want Finished", variant.mode, variant.difficulty, battle.Phase())
+		}
+	}
+}
+
+func TestSameSeedPlaysTheSameGameAgainstTheComputer(t *testing.T) {
+	//Arrange
+	input := "random\nA1\nA2\nA3\nA4\nA5\nA6\nA7\nA8\nA9\nA10\n"
+	var first, second strings.Builder
+
+	//Act
+	play(newConsole(strings.NewReader(input), &first, rand.New(rand.NewSource(3))), game.ClassicRules, 2, game.DifficultyHard)
+	play(newConsole(strings.NewReader(input), &second, rand.New(rand.NewSource(3))), game.ClassicRules, 2, game.DifficultyHard)
+
+	//Assert
+	if first.String() != second.String() {
+		t.Errorf("two games with seed 3 played differently")
+	}
+}
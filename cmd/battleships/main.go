@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"battleships/game"
+)
+
+func main() {
+	rulesName := flag.String("rules", "classic", "rules to play: default, classic, tournament or morskoi")
+	salvo := flag.Bool("salvo", false, "fire one shot per surviving ship each turn")
+	shootAgain := flag.Bool("shoot-again", false, "keep the turn after a hit")
+	vsComputer := flag.Bool("computer", false, "play against the computer instead of a second person")
+	difficultyName := flag.String("difficulty", "medium", "how well the computer plays: easy, medium, hard or expert")
+	seed := flag.Int64("seed", 0, "seed for the computer and random placement, 0 for a different game every time")
+	flag.Parse()
+
+	rules, rulesErr := rulesFromFlags(*rulesName, *salvo, *shootAgain)
+	if rulesErr != nil {
+		fmt.Fprintln(os.Stderr, rulesErr)
+		os.Exit(2)
+	}
+
+	difficulty, difficultyErr := game.ParseDifficulty(*difficultyName)
+	if difficultyErr != nil {
+		fmt.Fprintln(os.Stderr, difficultyErr)
+		os.Exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	console := newConsole(os.Stdin, os.Stdout, rand.New(rand.NewSource(*seed)))
+	computerPlayer := 0
+	if *vsComputer {
+		computerPlayer = 2
+	}
+	playErr := play(console, rules, computerPlayer, difficulty)
+	if playErr != nil {
+		fmt.Fprintln(os.Stderr, playErr)
+		os.Exit(1)
+	}
+}
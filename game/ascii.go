@@ -0,0 +1,187 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Boards are drawn with '.' for water, 'S' for ship, 'X' for a hit and 'o'
+// for a miss, under a header of column letters and beside row numbers. Views
+// also use '#' for the squares of a sunk ship. Ships lying end to end look
+// the same as one long ship, so the grid is followed by a line for each ship
+// naming its class, first square and direction, like "Cruiser C3 across".
+func (board Board) String() string {
+	var text strings.Builder
+	text.WriteString(formatGrid(board.Width(), board.Height(), func(row int, col int) string {
+		return board.cells[row][col].symbol()
+	}))
+	for _, ship := range board.ships {
+		fmt.Fprintf(&text, "%s %s %s\n", ship.Class, Coord{Row: ship.Row, Col: ship.Col}, directionWord(ship.Orientation))
+	}
+	return text.String()
+}
+
+// ParseBoard reads a board drawn by Board.String. Ships are placed from the
+// ship list in the order given, following the rules, and must cover exactly
+// the 'S' and 'X' squares of the grid.
+func ParseBoard(rules Rules, text string) (Board, error) {
+	board, boardErr := CreateBoard(rules)
+	if boardErr != nil {
+		return board, boardErr
+	}
+
+	cells, shipLines, gridErr := parseGrid(board.Width(), board.Height(), text)
+	if gridErr != nil {
+		return board, gridErr
+	}
+
+	for _, line := range shipLines {
+		placement, lineErr := parseShipLine(line)
+		if lineErr != nil {
+			return board, lineErr
+		}
+		var placeErr error
+		board, placeErr = PlaceShip(board, placement.Class, placement.Row, placement.Col, placement.Orientation)
+		if placeErr != nil {
+			return board, fmt.Errorf("%w: %w", ErrParseBoard, placeErr)
+		}
+	}
+
+	for row := range cells {
+		for col, cell := range cells[row] {
+			drawnAsShip := cell == CellShip || cell == CellHit
+			listed := board.cells[row][col] == CellShip
+			if drawnAsShip && !listed {
+				return board, fmt.Errorf("%w: ship square at %s is not in the ship list", ErrParseBoard, Coord{Row: row, Col: col})
+			}
+			if listed && !drawnAsShip {
+				return board, fmt.Errorf("%w: listed ship covers %s, which is not drawn as a ship square", ErrParseBoard, Coord{Row: row, Col: col})
+			}
+		}
+	}
+
+	for row := range cells {
+		for col, cell := range cells[row] {
+			if cell == CellHit || cell == CellMiss {
+				board, _, _, _ = shootOpponent(board, row, col)
+			}
+		}
+	}
+	return board, nil
+}
+
+// Class names may contain spaces, so the square and direction are read from
+// the end of the line.
+func parseShipLine(line []string) (ShipPlacement, error) {
+	if len(line) < 3 {
+		return ShipPlacement{}, fmt.Errorf("%w: ship line %q, want a class, a square and across or down", ErrParseBoard, strings.Join(line, " "))
+	}
+	coord, coordErr := ParseCoord(line[len(line)-2])
+	if coordErr != nil {
+		return ShipPlacement{}, fmt.Errorf("%w: %w", ErrParseBoard, coordErr)
+	}
+	placement := ShipPlacement{Class: strings.Join(line[:len(line)-2], " "), Row: coord.Row, Col: coord.Col}
+	switch line[len(line)-1] {
+	case directionWord(Horizontal):
+		placement.Orientation = Horizontal
+	case directionWord(Vertical):
+		placement.Orientation = Vertical
+	default:
+		return ShipPlacement{}, fmt.Errorf("%w: ship line %q, want across or down", ErrParseBoard, strings.Join(line, " "))
+	}
+	return placement, nil
+}
+
+func directionWord(orientation Orientation) string {
+	if orientation == Vertical {
+		return "down"
+	}
+	return "across"
+}
+
+func (cell Cell) symbol() string {
+	switch cell {
+	case CellShip:
+		return "S"
+	case CellHit:
+		return "X"
+	case CellMiss:
+		return "o"
+	case CellSunk:
+		return "#"
+	}
+	return "."
+}
+
+func cellForSymbol(symbol string) (Cell, bool) {
+	for _, cell := range []Cell{CellEmpty, CellShip, CellHit, CellMiss} {
+		if cell.symbol() == symbol {
+			return cell, true
+		}
+	}
+	return CellEmpty, false
+}
+
+func formatGrid(width int, height int, symbolAt func(row int, col int) string) string {
+	rowLabelWidth := len(rowLabel(height - 1))
+	colWidth := len(colLabel(width - 1))
+
+	var text strings.Builder
+	text.WriteString(strings.Repeat(" ", rowLabelWidth))
+	for col := 0; col < width; col++ {
+		fmt.Fprintf(&text, " %*s", colWidth, colLabel(col))
+	}
+	text.WriteString("\n")
+
+	for row := 0; row < height; row++ {
+		fmt.Fprintf(&text, "%*s", rowLabelWidth, rowLabel(row))
+		for col := 0; col < width; col++ {
+			fmt.Fprintf(&text, " %*s", colWidth, symbolAt(row, col))
+		}
+		text.WriteString("\n")
+	}
+	return text.String()
+}
+
+// parseGrid also returns the lines after the grid, split into fields.
+func parseGrid(width int, height int, text string) ([][]Cell, [][]string, error) {
+	var lines [][]string
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			lines = append(lines, fields)
+		}
+	}
+	if len(lines) < height+1 {
+		return nil, nil, fmt.Errorf("%w: got %d rows, want %d", ErrParseBoard, len(lines)-1, height)
+	}
+
+	header := lines[0]
+	if len(header) != width {
+		return nil, nil, fmt.Errorf("%w: got %d columns, want %d", ErrParseBoard, len(header), width)
+	}
+	for col, label := range header {
+		if label != colLabel(col) {
+			return nil, nil, fmt.Errorf("%w: column %d is labelled %q, want %q", ErrParseBoard, col+1, label, colLabel(col))
+		}
+	}
+
+	cells := make([][]Cell, height)
+	for row, fields := range lines[1 : height+1] {
+		if fields[0] != rowLabel(row) {
+			return nil, nil, fmt.Errorf("%w: row %d is labelled %q, want %q", ErrParseBoard, row+1, fields[0], rowLabel(row))
+		}
+		if len(fields) != width+1 {
+			return nil, nil, fmt.Errorf("%w: row %s has %d squares, want %d", ErrParseBoard, rowLabel(row), len(fields)-1, width)
+		}
+		cells[row] = make([]Cell, width)
+		for col, symbol := range fields[1:] {
+			cell, known := cellForSymbol(symbol)
+			if !known {
+				return nil, nil, fmt.Errorf("%w: unknown square %q at %s", ErrParseBoard, symbol, Coord{Row: row, Col: col})
+			}
+			cells[row][col] = cell
+		}
+	}
+	return cells, lines[height+1:], nil
+}
@@ -0,0 +1,253 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBoardStringDrawsShipsHitsAndMisses(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 4, 5, Horizontal)
+	gridWithHit, _, _, _ := shootOpponent(gridWith2Ships, 4, 5)
+	gridWithMiss, _, _, _ := shootOpponent(gridWithHit, 6, 0)
+
+	//Act
+	got := gridWithMiss.String()
+
+	//Assert
+	want := "  A B C D E F G\n" +
+		"1 . . . . . . .\n" +
+		"2 . . S . . . .\n" +
+		"3 . . . . . . .\n" +
+		"4 . . . . . . .\n" +
+		"5 . . . . . X .\n" +
+		"6 . . . . . . .\n" +
+		"7 o . . . . . .\n" +
+		"Battleship C2 across\n" +
+		"Battleship F5 across\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBoardStringPadsWideBoards(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(Rules{Width: 28, Height: 10, Fleet: DefaultFleet})
+	board, _ = PlaceShip(board, "Battleship", 9, 27, Horizontal)
+
+	//Act
+	got := board.String()
+
+	//Assert
+	want := "    A  B  C  D  E  F  G  H  I  J  K  L  M  N  O  P  Q  R  S  T  U  V  W  X  Y  Z AA AB\n" +
+		" 1  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 2  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 3  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 4  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 5  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 6  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 7  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 8  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		" 9  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .\n" +
+		"10  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  .  S\n" +
+		"Battleship AB10 across\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParseBoardRebuildsShipsAndShots(t *testing.T) {
+	//Arrange
+	text := `
+	   A B C D E F G H I J
+	 1 S S S S S . . . . .
+	 2 . . . . . . . . . .
+	 3 . X . . . . o . . .
+	 4 . X . S S S . . . .
+	 5 . X . . . . . . . .
+	 6 . S . . . . . . . .
+	 7 . . . . . . . . . S
+	 8 . . . o . . . . . S
+	 9 . . . . . . . . . S
+	10 X X . . . . . . . .
+	Carrier A1 across
+	Battleship B3 down
+	Cruiser D4 across
+	Submarine J7 down
+	Destroyer A10 across
+	`
+
+	//Act
+	got, err := ParseBoard(ClassicRules, text)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	want := []Ship{
+		{Class: "Carrier", Length: 5, Row: 0, Col: 0, Orientation: Horizontal},
+		{Class: "Battleship", Length: 4, Row: 2, Col: 1, Orientation: Vertical, Hits: 3},
+		{Class: "Cruiser", Length: 3, Row: 3, Col: 3, Orientation: Horizontal},
+		{Class: "Submarine", Length: 3, Row: 6, Col: 9, Orientation: Vertical},
+		{Class: "Destroyer", Length: 2, Row: 9, Col: 0, Orientation: Horizontal, Hits: 2},
+	}
+	if !reflect.DeepEqual(got.Ships(), want) {
+		t.Errorf("got %+v, want %+v", got.Ships(), want)
+	}
+	if got.cells[2][6] != CellMiss || got.cells[7][3] != CellMiss {
+		t.Errorf("misses were not recorded:\n%s", got)
+	}
+	if countOfShipsAfloat(got) != 4 {
+		t.Errorf("got %d ships afloat, want 4", countOfShipsAfloat(got))
+	}
+}
+
+func TestParseBoardRoundTripsBoardString(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceFleet(board, classicLayout)
+	board, _, _, _ = shootOpponent(board, 0, 3)
+	board, _, _, _ = shootOpponent(board, 8, 0)
+	board, _, _, _ = shootOpponent(board, 8, 1)
+	board, _, _, _ = shootOpponent(board, 9, 9)
+
+	//Act
+	got, err := ParseBoard(ClassicRules, board.String())
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if !reflect.DeepEqual(got, board) {
+		t.Errorf("got\n%s\nwant\n%s", got, board)
+	}
+}
+
+func TestParseBoardRoundTripsAdjacentShips(t *testing.T) {
+	//Arrange
+	board := CreateGrid()
+	board, _ = PlaceShip(board, "Battleship", 1, 2, Horizontal)
+	board, _ = PlaceShip(board, "Battleship", 1, 3, Horizontal)
+	board, _ = PlaceShip(board, "Battleship", 2, 3, Horizontal)
+	board, _, _, _ = shootOpponent(board, 1, 3)
+
+	//Act
+	got, err := ParseBoard(DefaultRules, board.String())
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if !reflect.DeepEqual(got, board) {
+		t.Errorf("got\n%s\nwant\n%s", got, board)
+	}
+}
+
+func TestParseBoardKeepsShipsLyingEndToEndApart(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Destroyer", 0, 0, Horizontal)
+	board, _ = PlaceShip(board, "Cruiser", 0, 2, Horizontal)
+	board, _ = PlaceShip(board, "Carrier", 3, 9, Vertical)
+	board, _, _, _ = shootOpponent(board, 0, 0)
+	board, _, _, _ = shootOpponent(board, 0, 1)
+
+	//Act
+	got, err := ParseBoard(ClassicRules, board.String())
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if !reflect.DeepEqual(got, board) {
+		t.Errorf("got\n%s\nwant\n%s", got, board)
+	}
+	if countOfShipsAfloat(got) != 2 {
+		t.Errorf("got %d ships afloat, want 2 after the Destroyer is sunk", countOfShipsAfloat(got))
+	}
+}
+
+func TestParseBoardRoundTripsRandomLayouts(t *testing.T) {
+	for seed := int64(0); seed < 200; seed++ {
+		//arrange
+		rng := rand.New(rand.NewSource(seed))
+		board, _ := CreateBoard(ClassicRules)
+		board, _ = PlaceFleetRandomly(board, ClassicFleet, rng)
+		for shot := 0; shot < 30; shot++ {
+			board, _, _, _ = shootOpponent(board, rng.Intn(10), rng.Intn(10))
+		}
+
+		//act
+		got, err := ParseBoard(ClassicRules, board.String())
+
+		//assert
+		if err != nil {
+			t.Fatalf("seed %d: got %v, want no error", seed, err)
+		}
+		if !reflect.DeepEqual(got, board) {
+			t.Errorf("seed %d: got\n%s\nwant\n%s", seed, got, board)
+		}
+	}
+}
+
+func TestParseBoardRejectsBadText(t *testing.T) {
+	type board struct {
+		name string
+		text string
+	}
+	boards := []board{
+		{name: "too few rows", text: "  A B C\n1 . . .\n2 . . .\n"},
+		{name: "too few columns", text: "  A B\n1 . .\n2 . .\n3 . .\n"},
+		{name: "wrong header", text: "  A B D\n1 . . .\n2 . . .\n3 . . .\n"},
+		{name: "wrong row label", text: "  A B C\n1 . . .\n3 . . .\n3 . . .\n"},
+		{name: "short row", text: "  A B C\n1 . . .\n2 . .\n3 . . .\n"},
+		{name: "unknown square", text: "  A B C\n1 . . .\n2 . ? .\n3 . . .\n"},
+		{name: "ship not in fleet", text: "  A B C\n1 S S S\n2 . . .\n3 . . .\nCruiser A1 across\n"},
+		{name: "ship not listed", text: "  A B C\n1 S S .\n2 . . .\n3 . . .\n"},
+		{name: "listed ship not drawn", text: "  A B C\n1 S . .\n2 . . .\n3 . . .\nDestroyer A1 across\n"},
+		{name: "bad direction", text: "  A B C\n1 S S .\n2 . . .\n3 . . .\nDestroyer A1 sideways\n"},
+		{name: "bad square", text: "  A B C\n1 S S .\n2 . . .\n3 . . .\nDestroyer 1A across\n"},
+		{name: "short ship line", text: "  A B C\n1 S S .\n2 . . .\n3 . . .\nDestroyer A1\n"},
+	}
+
+	for _, board := range boards {
+		//act
+		_, got := ParseBoard(Rules{Width: 3, Height: 3, Fleet: FleetSpec{{Name: "Destroyer", Length: 2, Count: 1}}}, board.text)
+
+		//assert
+		if !errors.Is(got, ErrParseBoard) {
+			t.Errorf("%s: got %v, want %v", board.name, got, ErrParseBoard)
+		}
+	}
+}
+
+func TestParseBoardFollowsPlacementRules(t *testing.T) {
+	//Arrange
+	text := `
+	   A B C D E F G H I J
+	 1 S . . . . . . . . .
+	 2 . S . . . . . . . .
+	 3 . . . . . . . . . .
+	 4 . . . . . . . . . .
+	 5 . . . . . . . . . .
+	 6 . . . . . . . . . .
+	 7 . . . . . . . . . .
+	 8 . . . . . . . . . .
+	 9 . . . . . . . . . .
+	10 . . . . . . . . . .
+	Boat A1 across
+	Boat B2 across
+	`
+
+	//Act
+	_, got := ParseBoard(MorskoiBoiRules, text)
+
+	//Assert
+	if !errors.Is(got, ErrParseBoard) || !errors.Is(got, ErrShipsTouching) {
+		t.Errorf("got %v, want %v and %v", got, ErrParseBoard, ErrShipsTouching)
+	}
+}
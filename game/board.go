@@ -0,0 +1,118 @@
+package game
+
+import "fmt"
+
+type Rules struct {
+	Width  int
+	Height int
+	Fleet  FleetSpec
+	// NoTouching stops ships being placed next to each other, even diagonally.
+	NoTouching bool
+	Mode       Mode
+	TurnPolicy TurnPolicy
+}
+
+type Mode int
+
+const (
+	ModeClassic Mode = iota
+	// ModeSalvo gives each player one shot per surviving ship every turn.
+	ModeSalvo
+)
+
+type TurnPolicy int
+
+const (
+	TurnPolicyAlternate TurnPolicy = iota
+	// TurnPolicyShootAgainOnHit keeps the turn with a player whose single shot
+	// hits. Salvos always pass the turn.
+	TurnPolicyShootAgainOnHit
+)
+
+func (mode Mode) String() string {
+	switch mode {
+	case ModeClassic:
+		return "Classic"
+	case ModeSalvo:
+		return "Salvo"
+	}
+	return "Unknown"
+}
+
+var DefaultRules = Rules{Width: 7, Height: 7, Fleet: DefaultFleet}
+var ClassicRules = Rules{Width: 10, Height: 10, Fleet: ClassicFleet}
+var TournamentRules = Rules{Width: 15, Height: 15, Fleet: TournamentFleet}
+var MorskoiBoiRules = Rules{Width: 10, Height: 10, Fleet: MorskoiBoiFleet, NoTouching: true}
+
+type Board struct {
+	rules Rules
+	cells [][]Cell
+	ships []Ship
+}
+
+func CreateBoard(rules Rules) (Board, error) {
+	if rules.Width < 1 || rules.Height < 1 {
+		return Board{}, fmt.Errorf("%w: %dx%d, want at least 1x1", ErrInvalidBoardSize, rules.Width, rules.Height)
+	}
+	fleetErr := rules.Fleet.validate()
+	if fleetErr != nil {
+		return Board{}, fleetErr
+	}
+
+	cells := make([][]Cell, rules.Height)
+	for row := range cells {
+		cells[row] = make([]Cell, rules.Width)
+	}
+	return Board{rules: rules, cells: cells}, nil
+}
+
+func CreateGrid() Board {
+	board, _ := CreateBoard(DefaultRules)
+	return board
+}
+
+func (board Board) Width() int {
+	return board.rules.Width
+}
+
+func (board Board) Height() int {
+	return board.rules.Height
+}
+
+func (board Board) Rules() Rules {
+	return board.rules
+}
+
+// Boards are passed around by value, so anything that changes a cell must
+// work on a copy to leave the caller's board untouched.
+func (board Board) clone() Board {
+	cells := make([][]Cell, len(board.cells))
+	for row := range board.cells {
+		cells[row] = append([]Cell(nil), board.cells[row]...)
+	}
+	board.cells = cells
+	board.ships = append([]Ship(nil), board.ships...)
+	return board
+}
+
+func (board Board) Ships() []Ship {
+	return append([]Ship(nil), board.ships...)
+}
+
+func (board Board) shipIndexAt(row int, col int) int {
+	for i, placed := range board.ships {
+		for _, square := range placed.squares() {
+			if square[0] == row && square[1] == col {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func areCoordinatesOnPlayingGrid(board Board, row int, col int) error {
+	if row < 0 || row >= board.Height() || col < 0 || col >= board.Width() {
+		return &OutOfBoundsError{Row: row, Col: col, Width: board.Width(), Height: board.Height()}
+	}
+	return nil
+}
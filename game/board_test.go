@@ -0,0 +1,274 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestCreateBoardUsesRulesDimensions(t *testing.T) {
+	type dimensions struct {
+		rules  Rules
+		width  int
+		height int
+	}
+	boardSizes := []dimensions{
+		{rules: DefaultRules, width: 7, height: 7},
+		{rules: ClassicRules, width: 10, height: 10},
+		{rules: TournamentRules, width: 15, height: 15},
+		{rules: Rules{Width: 12, Height: 8}, width: 12, height: 8},
+	}
+
+	for _, size := range boardSizes {
+		//act
+		board, err := CreateBoard(size.rules)
+
+		//assert
+		if err != nil {
+			t.Fatalf("got %v, want no error", err)
+		}
+		if board.Width() != size.width || board.Height() != size.height {
+			t.Errorf("got %dx%d, want %dx%d", board.Width(), board.Height(), size.width, size.height)
+		}
+	}
+}
+
+func TestCannotCreateBoardWithoutSquares(t *testing.T) {
+	//Act
+	_, got := CreateBoard(Rules{Width: 0, Height: 10})
+
+	//Assert
+	want := errors.New("invalid board size: 0x10, want at least 1x1")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBoundsFollowBoardDimensions(t *testing.T) {
+	type coordinates struct {
+		row       int
+		col       int
+		errorText string
+	}
+	shipCoordinates := []coordinates{
+		{row: 8, col: 0, errorText: "A9 is off the grid, want A1 to L8"},
+		{row: 0, col: 12, errorText: "M1 is off the grid, want A1 to L8"},
+	}
+
+	for _, coordinates := range shipCoordinates {
+		//arrange
+		board, _ := CreateBoard(Rules{Width: 12, Height: 8, Fleet: DefaultFleet})
+
+		//act
+		_, got := PlaceShip(board, "Battleship", coordinates.row, coordinates.col, Horizontal)
+
+		//assert
+		want := errors.New(coordinates.errorText)
+		if got == nil || got.Error() != want.Error() {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCanPlaceShipInCornerOfLargeBoard(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(TournamentRules)
+
+	//Act
+	_, got := PlaceShip(board, "Destroyer", 13, 14, Vertical)
+
+	//Assert
+	if got != nil {
+		t.Errorf("got %v, want no error", got)
+	}
+}
+
+func TestPlaceShipDoesNotChangeOriginalBoard(t *testing.T) {
+	//Arrange
+	board := CreateGrid()
+
+	//Act
+	PlaceShip(board, "Battleship", 2, 2, Horizontal)
+
+	//Assert
+	if countOfShipsOnGrid(board) != 0 {
+		t.Errorf("original board was changed, got %d ships want 0", countOfShipsOnGrid(board))
+	}
+}
+
+func TestPlaceShipFillsEveryCellOfShip(t *testing.T) {
+	type placement struct {
+		class       string
+		row         int
+		col         int
+		orientation Orientation
+		cells       [][2]int
+	}
+	placements := []placement{
+		{class: "Cruiser", row: 1, col: 2, orientation: Horizontal, cells: [][2]int{{1, 2}, {1, 3}, {1, 4}}},
+		{class: "Battleship", row: 3, col: 6, orientation: Vertical, cells: [][2]int{{3, 6}, {4, 6}, {5, 6}, {6, 6}}},
+	}
+
+	for _, placement := range placements {
+		//arrange
+		board, _ := CreateBoard(ClassicRules)
+
+		//act
+		got, err := PlaceShip(board, placement.class, placement.row, placement.col, placement.orientation)
+
+		//assert
+		if err != nil {
+			t.Fatalf("got %v, want no error", err)
+		}
+		for _, cell := range placement.cells {
+			if got.cells[cell[0]][cell[1]] != CellShip {
+				t.Errorf("no ship at row %d column %d", cell[0], cell[1])
+			}
+		}
+		if countOfShipsOnGrid(got) != len(placement.cells) {
+			t.Errorf("got %d ship squares, want %d", countOfShipsOnGrid(got), len(placement.cells))
+		}
+	}
+}
+
+func TestCannotPlaceShipRunningOffGrid(t *testing.T) {
+	type placement struct {
+		class       string
+		row         int
+		col         int
+		orientation Orientation
+		errorText   string
+	}
+	placements := []placement{
+		{class: "Cruiser", row: 0, col: 8, orientation: Horizontal, errorText: "K1 is off the grid, want A1 to J10"},
+		{class: "Carrier", row: 6, col: 0, orientation: Vertical, errorText: "A11 is off the grid, want A1 to J10"},
+	}
+
+	for _, placement := range placements {
+		//arrange
+		board, _ := CreateBoard(ClassicRules)
+
+		//act
+		got, err := PlaceShip(board, placement.class, placement.row, placement.col, placement.orientation)
+
+		//assert
+		want := errors.New(placement.errorText)
+		if err == nil || err.Error() != want.Error() {
+			t.Errorf("got %v, want %v", err, want)
+		}
+		if countOfShipsOnGrid(got) != 0 {
+			t.Errorf("partly placed ship left on grid, got %d ship squares want 0", countOfShipsOnGrid(got))
+		}
+	}
+}
+
+func TestCannotPlaceShipCrossingAnotherShip(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	boardWithShip, _ := PlaceShip(board, "Cruiser", 2, 1, Horizontal)
+
+	//Act
+	got, err := PlaceShip(boardWithShip, "Battleship", 0, 2, Vertical)
+
+	//Assert
+	want := errors.New("ship already placed at C3")
+	if err == nil || err.Error() != want.Error() {
+		t.Errorf("got %v, want %v", err, want)
+	}
+	if len(got.Ships()) != 1 || countOfShipsOnGrid(got) != 3 {
+		t.Errorf("got %d ships on %d squares, want 1 ship on 3 squares", len(got.Ships()), countOfShipsOnGrid(got))
+	}
+}
+
+func TestNoTouchingRejectsShipsSideBySideOrDiagonal(t *testing.T) {
+	type placement struct {
+		row         int
+		col         int
+		orientation Orientation
+	}
+	placements := []placement{
+		{row: 3, col: 2, orientation: Horizontal},
+		{row: 0, col: 5, orientation: Vertical},
+		{row: 3, col: 5, orientation: Horizontal},
+		{row: 1, col: 0, orientation: Horizontal},
+	}
+
+	for _, placement := range placements {
+		//arrange
+		board, _ := CreateBoard(MorskoiBoiRules)
+		board, _ = PlaceShip(board, "Cruiser", 2, 2, Horizontal)
+
+		//act
+		got, err := PlaceShip(board, "Destroyer", placement.row, placement.col, placement.orientation)
+
+		//assert
+		want := errors.New("ships cannot touch: would touch Cruiser placed at C3")
+		if err == nil || err.Error() != want.Error() {
+			t.Errorf("got %v, want %v", err, want)
+		}
+		if len(got.Ships()) != 1 {
+			t.Errorf("got %d ships, want 1", len(got.Ships()))
+		}
+	}
+}
+
+func TestNoTouchingAllowsShipsOneSquareApart(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(MorskoiBoiRules)
+	board, _ = PlaceShip(board, "Cruiser", 2, 2, Horizontal)
+
+	//Act
+	_, got := PlaceShip(board, "Destroyer", 4, 2, Horizontal)
+
+	//Assert
+	if got != nil {
+		t.Errorf("got %v, want no error", got)
+	}
+}
+
+func TestShipsMayTouchWithoutNoTouchingRule(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Cruiser", 2, 2, Horizontal)
+
+	//Act
+	_, got := PlaceShip(board, "Destroyer", 3, 2, Horizontal)
+
+	//Assert
+	if got != nil {
+		t.Errorf("got %v, want no error", got)
+	}
+}
+
+func TestRandomFleetFollowsNoTouchingRule(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(MorskoiBoiRules)
+
+	//Act
+	got, err := PlaceFleetRandomly(board, MorskoiBoiFleet, rand.New(rand.NewSource(3)))
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	ships := got.Ships()
+	for i := range ships {
+		others, _ := CreateBoard(MorskoiBoiRules)
+		others.ships = append(append([]Ship(nil), ships[:i]...), ships[i+1:]...)
+		if touchErr := checkNotTouching(others, ships[i]); touchErr != nil {
+			t.Errorf("%s at row %d column %d: %v", ships[i].Class, ships[i].Row, ships[i].Col, touchErr)
+		}
+	}
+}
+
+func countOfShipsOnGrid(board Board) int {
+	shipCount := 0
+	for _, row := range board.cells {
+		for _, coordinates := range row {
+			if coordinates == CellShip {
+				shipCount++
+			}
+		}
+	}
+	return shipCount
+}
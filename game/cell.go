@@ -0,0 +1,29 @@
+package game
+
+type Cell int
+
+const (
+	CellEmpty Cell = iota
+	CellShip
+	CellHit
+	CellMiss
+	// CellSunk only appears in views, where every square of a sunk ship is
+	// shown as sunk rather than hit.
+	CellSunk
+)
+
+func (cell Cell) String() string {
+	switch cell {
+	case CellEmpty:
+		return "Empty"
+	case CellShip:
+		return "Ship"
+	case CellHit:
+		return "Hit"
+	case CellMiss:
+		return "Miss"
+	case CellSunk:
+		return "Sunk"
+	}
+	return "Unknown"
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Coord is a zero-based square. As text it is written chess style, with
+// column letters (A to Z, then AA, AB and so on) followed by a row number
+// counting from 1, so Coord{Row: 3, Col: 1} is "B4".
+type Coord struct {
+	Row int
+	Col int
+}
+
+func ParseCoord(text string) (Coord, error) {
+	text = strings.ToUpper(strings.TrimSpace(text))
+
+	letters := 0
+	for letters < len(text) && text[letters] >= 'A' && text[letters] <= 'Z' {
+		letters++
+	}
+	if letters == 0 || letters == len(text) {
+		return Coord{}, fmt.Errorf("%w: %q, want a column letter then a row number like B4", ErrInvalidCoord, text)
+	}
+
+	col := 0
+	for _, letter := range text[:letters] {
+		if col > (math.MaxInt-26)/26 {
+			return Coord{}, fmt.Errorf("%w: %q has too many column letters", ErrInvalidCoord, text)
+		}
+		col = col*26 + int(letter-'A') + 1
+	}
+
+	row, rowErr := strconv.Atoi(text[letters:])
+	if rowErr != nil || row < 1 || text[letters] == '+' {
+		return Coord{}, fmt.Errorf("%w: %q, want a column letter then a row number like B4", ErrInvalidCoord, text)
+	}
+
+	return Coord{Row: row - 1, Col: col - 1}, nil
+}
+
+// Squares above or left of the grid have no letter-number name, so they are
+// written out with the zero-based row and column exactly as given.
+func (coord Coord) String() string {
+	if coord.Row < 0 || coord.Col < 0 {
+		return fmt.Sprintf("row %d column %d", coord.Row, coord.Col)
+	}
+	return colLabel(coord.Col) + rowLabel(coord.Row)
+}
+
+func rowLabel(row int) string {
+	return strconv.Itoa(row + 1)
+}
+
+func colLabel(col int) string {
+	label := ""
+	for n := col + 1; n > 0; n = (n - 1) / 26 {
+		label = string(rune('A'+(n-1)%26)) + label
+	}
+	return label
+}
@@ -0,0 +1,90 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseCoord(t *testing.T) {
+	type coordinates struct {
+		text string
+		want Coord
+	}
+	squares := []coordinates{
+		{text: "A1", want: Coord{Row: 0, Col: 0}},
+		{text: "B4", want: Coord{Row: 3, Col: 1}},
+		{text: "g7", want: Coord{Row: 6, Col: 6}},
+		{text: " J10 ", want: Coord{Row: 9, Col: 9}},
+		{text: "Z1", want: Coord{Row: 0, Col: 25}},
+		{text: "AA1", want: Coord{Row: 0, Col: 26}},
+		{text: "AZ3", want: Coord{Row: 2, Col: 51}},
+		{text: "BA12", want: Coord{Row: 11, Col: 52}},
+	}
+
+	for _, square := range squares {
+		//act
+		got, err := ParseCoord(square.text)
+
+		//assert
+		if err != nil || got != square.want {
+			t.Errorf("%q: got %+v and %v, want %+v", square.text, got, err, square.want)
+		}
+	}
+}
+
+func TestParseCoordRejectsBadText(t *testing.T) {
+	squares := []string{"", "A", "4", "4B", "A0", "A-1", "A+1", "B4C", "Ä1", strings.Repeat("A", 50) + "1", "ZZZZZZZZZZZZZZZ1"}
+
+	for _, square := range squares {
+		//act
+		_, got := ParseCoord(square)
+
+		//assert
+		if !errors.Is(got, ErrInvalidCoord) {
+			t.Errorf("%q: got %v, want %v", square, got, ErrInvalidCoord)
+		}
+	}
+}
+
+func TestCoordString(t *testing.T) {
+	type coordinates struct {
+		coord Coord
+		want  string
+	}
+	squares := []coordinates{
+		{coord: Coord{Row: 0, Col: 0}, want: "A1"},
+		{coord: Coord{Row: 3, Col: 1}, want: "B4"},
+		{coord: Coord{Row: 14, Col: 14}, want: "O15"},
+		{coord: Coord{Row: 0, Col: 26}, want: "AA1"},
+		{coord: Coord{Row: 0, Col: 701}, want: "ZZ1"},
+		{coord: Coord{Row: 0, Col: 702}, want: "AAA1"},
+		{coord: Coord{Row: -1, Col: 2}, want: "row -1 column 2"},
+		{coord: Coord{Row: 4, Col: -1}, want: "row 4 column -1"},
+	}
+
+	for _, square := range squares {
+		//act
+		got := square.coord.String()
+
+		//assert
+		if got != square.want {
+			t.Errorf("got %q, want %q", got, square.want)
+		}
+	}
+}
+
+func TestCoordRoundTripsThroughText(t *testing.T) {
+	for col := 0; col < 1000; col += 7 {
+		//arrange
+		coord := Coord{Row: col % 30, Col: col}
+
+		//act
+		got, err := ParseCoord(coord.String())
+
+		//assert
+		if err != nil || got != coord {
+			t.Errorf("%s: got %+v and %v, want %+v", coord, got, err, coord)
+		}
+	}
+}
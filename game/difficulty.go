@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+type Difficulty int
+
+const (
+	DifficultyEasy Difficulty = iota
+	DifficultyMedium
+	DifficultyHard
+	DifficultyExpert
+)
+
+func (difficulty Difficulty) String() string {
+	switch difficulty {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyMedium:
+		return "Medium"
+	case DifficultyHard:
+		return "Hard"
+	case DifficultyExpert:
+		return "Expert"
+	}
+	return "Unknown"
+}
+
+// ParseDifficulty accepts the names String gives, in any case.
+func ParseDifficulty(text string) (Difficulty, error) {
+	for difficulty := DifficultyEasy; difficulty <= DifficultyExpert; difficulty++ {
+		if strings.EqualFold(strings.TrimSpace(text), difficulty.String()) {
+			return difficulty, nil
+		}
+	}
+	return DifficultyEasy, fmt.Errorf("%w: %q, want Easy, Medium, Hard or Expert", ErrUnknownDifficulty, text)
+}
+
+// NewStrategyForDifficulty builds the computer opponent for a difficulty.
+// Everything it does comes from rng, so the same seed plays the same game.
+func NewStrategyForDifficulty(difficulty Difficulty, rng *rand.Rand) (Strategy, error) {
+	switch difficulty {
+	case DifficultyEasy:
+		return NewRandomStrategy(rng), nil
+	case DifficultyMedium:
+		return WithMistakes(NewHuntTargetStrategy(rng), 0.25, rng), nil
+	case DifficultyHard:
+		return WithMistakes(NewProbabilityStrategy(rng), 0.1, rng), nil
+	case DifficultyExpert:
+		return NewProbabilityStrategy(rng), nil
+	}
+	return nil, fmt.Errorf("%w: %d", ErrUnknownDifficulty, difficulty)
+}
+
+type mistakeStrategy struct {
+	strategy    Strategy
+	mistakeRate float64
+	rng         *rand.Rand
+}
+
+// WithMistakes makes strategy fire at a random untargeted square instead, and
+// so ignore whatever it knows about open hits, on a mistakeRate share of its
+// shots.
+func WithMistakes(strategy Strategy, mistakeRate float64, rng *rand.Rand) Strategy {
+	return &mistakeStrategy{strategy: strategy, mistakeRate: mistakeRate, rng: rng}
+}
+
+func (strategy *mistakeStrategy) NextShot(tracking View) Coord {
+	if strategy.rng.Float64() < strategy.mistakeRate {
+		untargeted := untargetedSquares(tracking)
+		if len(untargeted) > 0 {
+			return untargeted[strategy.rng.Intn(len(untargeted))]
+		}
+	}
+	return strategy.strategy.NextShot(tracking)
+}
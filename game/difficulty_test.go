@@ -0,0 +1,92 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestParseDifficulty(t *testing.T) {
+	type difficultyText struct {
+		text       string
+		difficulty Difficulty
+	}
+	texts := []difficultyText{
+		{text: "Easy", difficulty: DifficultyEasy},
+		{text: "medium", difficulty: DifficultyMedium},
+		{text: " HARD ", difficulty: DifficultyHard},
+		{text: "Expert", difficulty: DifficultyExpert},
+	}
+
+	for _, text := range texts {
+		//act
+		got, err := ParseDifficulty(text.text)
+
+		//assert
+		if err != nil || got != text.difficulty {
+			t.Errorf("%q: got %v %v, want %v", text.text, got, err, text.difficulty)
+		}
+	}
+}
+
+func TestParseDifficultyRejectsUnknownNames(t *testing.T) {
+	//Act
+	_, got := ParseDifficulty("impossible")
+
+	//Assert
+	want := `unknown difficulty: "impossible", want Easy, Medium, Hard or Expert`
+	if !errors.Is(got, ErrUnknownDifficulty) || got.Error() != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDifficultyIsReproducibleWithASeed(t *testing.T) {
+	for difficulty := DifficultyEasy; difficulty <= DifficultyExpert; difficulty++ {
+		//arrange
+		board, _ := CreateBoard(ClassicRules)
+		board, _ = PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(5)))
+		first, _ := NewStrategyForDifficulty(difficulty, rand.New(rand.NewSource(9)))
+		second, _ := NewStrategyForDifficulty(difficulty, rand.New(rand.NewSource(9)))
+
+		//act
+		got := shotsToSink(t, board, first)
+		want := shotsToSink(t, board, second)
+
+		//assert
+		if got != want {
+			t.Errorf("%s: got %d shots, then %d with the same seed", difficulty, got, want)
+		}
+	}
+}
+
+func TestHarderDifficultiesSinkFleetsFaster(t *testing.T) {
+	//Arrange
+	totals := make([]int, DifficultyExpert+1)
+
+	//Act
+	for seed := int64(0); seed < 20; seed++ {
+		board, _ := CreateBoard(ClassicRules)
+		board, _ = PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(seed)))
+		for difficulty := DifficultyEasy; difficulty <= DifficultyExpert; difficulty++ {
+			strategy, _ := NewStrategyForDifficulty(difficulty, rand.New(rand.NewSource(seed)))
+			totals[difficulty] += shotsToSink(t, board, strategy)
+		}
+	}
+
+	//Assert
+	for difficulty := DifficultyMedium; difficulty <= DifficultyExpert; difficulty++ {
+		if totals[difficulty] >= totals[difficulty-1] {
+			t.Errorf("%s took %d shots over 20 games, want fewer than %s's %d", difficulty, totals[difficulty], difficulty-1, totals[difficulty-1])
+		}
+	}
+}
+
+func TestUnknownDifficultyHasNoStrategy(t *testing.T) {
+	//Act
+	_, got := NewStrategyForDifficulty(Difficulty(9), rand.New(rand.NewSource(1)))
+
+	//Assert
+	if !errors.Is(got, ErrUnknownDifficulty) {
+		t.Errorf("got %v, want %v", got, ErrUnknownDifficulty)
+	}
+}
@@ -0,0 +1,81 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidBoardSize   = errors.New("invalid board size")
+	ErrInvalidFleet       = errors.New("invalid fleet")
+	ErrInvalidPlayer      = errors.New("invalid player")
+	ErrInvalidOrientation = errors.New("invalid orientation")
+	ErrUnknownShipClass   = errors.New("unknown ship class")
+	ErrOutOfBounds        = errors.New("coordinates are off the grid")
+	ErrCellOccupied       = errors.New("ship already placed")
+	ErrShipsTouching      = errors.New("ships cannot touch")
+	ErrFleetFull          = errors.New("too many ships")
+	ErrFleetIncomplete    = errors.New("too few ships")
+	ErrFleetDoesNotFit    = errors.New("fleet does not fit on the board")
+	ErrAlreadyShot        = errors.New("square already shot")
+	ErrWrongPhase         = errors.New("wrong game phase")
+	ErrNotYourTurn        = errors.New("not your turn")
+	ErrGameOver           = errors.New("game over")
+	ErrWrongMode          = errors.New("wrong game mode")
+	ErrSalvoSize          = errors.New("wrong number of shots in salvo")
+	ErrParseBoard         = errors.New("cannot parse board")
+	ErrInvalidCoord       = errors.New("invalid coordinate")
+	ErrUnknownDifficulty  = errors.New("unknown difficulty")
+	ErrInvalidGameCount   = errors.New("invalid number of games")
+)
+
+// OutOfBoundsError matches ErrOutOfBounds and keeps the square that missed the grid.
+type OutOfBoundsError struct {
+	Row    int
+	Col    int
+	Width  int
+	Height int
+}
+
+func (err *OutOfBoundsError) Error() string {
+	return fmt.Sprintf("%s is off the grid, want A1 to %s", Coord{Row: err.Row, Col: err.Col}, Coord{Row: err.Height - 1, Col: err.Width - 1})
+}
+
+func (err *OutOfBoundsError) Is(target error) bool {
+	return target == ErrOutOfBounds
+}
+
+// TouchingError matches ErrShipsTouching and names the ship that was in the way.
+type TouchingError struct {
+	Ship Ship
+}
+
+func (err *TouchingError) Error() string {
+	return fmt.Sprintf("ships cannot touch: would touch %s placed at %s", err.Ship.Class, Coord{Row: err.Ship.Row, Col: err.Ship.Col})
+}
+
+func (err *TouchingError) Is(target error) bool {
+	return target == ErrShipsTouching
+}
+
+// FleetError matches ErrFleetFull when a class has too many ships and
+// ErrFleetIncomplete when it has too few.
+type FleetError struct {
+	Class  string
+	Placed int
+	Count  int
+}
+
+func (err *FleetError) Error() string {
+	if err.Placed >= err.Count {
+		return fmt.Sprintf("too many ships of class %s: fleet has %d", err.Class, err.Count)
+	}
+	return fmt.Sprintf("too few ships of class %s: placed %d, fleet has %d", err.Class, err.Placed, err.Count)
+}
+
+func (err *FleetError) Is(target error) bool {
+	if err.Placed >= err.Count {
+		return target == ErrFleetFull
+	}
+	return target == ErrFleetIncomplete
+}
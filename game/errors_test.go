@@ -0,0 +1,122 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOutOfBoundsErrorReportsSquare(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	_, err := PlaceShip(board, "Cruiser", 9, 8, Horizontal)
+
+	//Assert
+	var got *OutOfBoundsError
+	if !errors.As(err, &got) {
+		t.Fatalf("got %v, want an OutOfBoundsError", err)
+	}
+	want := OutOfBoundsError{Row: 9, Col: 10, Width: 10, Height: 10}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+	if !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("got %v, want it to be ErrOutOfBounds", err)
+	}
+}
+
+func TestShotOffGridIsErrOutOfBounds(t *testing.T) {
+	//Arrange
+	game := gameInBattle(1, CreateGrid())
+
+	//Act
+	_, got := game.CurrentPlayerTakeShot(1, -1, 3)
+
+	//Assert
+	if !errors.Is(got, ErrOutOfBounds) {
+		t.Errorf("got %v, want it to be ErrOutOfBounds", got)
+	}
+}
+
+func TestPlacementErrorsMatchSentinels(t *testing.T) {
+	type placement struct {
+		class       string
+		row         int
+		col         int
+		orientation Orientation
+		want        error
+	}
+	placements := []placement{
+		{class: "Cruiser", row: 0, col: 1, orientation: Vertical, want: ErrCellOccupied},
+		{class: "Destroyer", row: 5, col: 5, orientation: Horizontal, want: ErrFleetFull},
+		{class: "Frigate", row: 5, col: 5, orientation: Horizontal, want: ErrUnknownShipClass},
+		{class: "Cruiser", row: 5, col: 5, orientation: Orientation(7), want: ErrInvalidOrientation},
+	}
+
+	for _, placement := range placements {
+		//arrange
+		board, _ := CreateBoard(ClassicRules)
+		board, _ = PlaceShip(board, "Destroyer", 0, 0, Horizontal)
+
+		//act
+		_, got := PlaceShip(board, placement.class, placement.row, placement.col, placement.orientation)
+
+		//assert
+		if !errors.Is(got, placement.want) {
+			t.Errorf("got %v, want %v", got, placement.want)
+		}
+	}
+}
+
+func TestFleetErrorReportsClass(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Destroyer", 0, 0, Horizontal)
+
+	//Act
+	_, err := PlaceShip(board, "Destroyer", 2, 0, Horizontal)
+
+	//Assert
+	var got *FleetError
+	if !errors.As(err, &got) {
+		t.Fatalf("got %v, want a FleetError", err)
+	}
+	if got.Class != "Destroyer" || got.Count != 1 {
+		t.Errorf("got %+v, want class Destroyer with count 1", *got)
+	}
+}
+
+func TestCheckFleetErrorsMatchErrFleetIncomplete(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	got := CheckFleet(board)
+
+	//Assert
+	if !errors.Is(got, ErrFleetIncomplete) {
+		t.Errorf("got %v, want %v", got, ErrFleetIncomplete)
+	}
+	if errors.Is(got, ErrFleetFull) {
+		t.Errorf("got %v, want it not to be %v", got, ErrFleetFull)
+	}
+}
+
+func TestInvalidRulesMatchSentinels(t *testing.T) {
+	//Act
+	_, sizeErr := CreateBoard(Rules{Width: 0, Height: 0, Fleet: DefaultFleet})
+	_, fleetErr := CreateBoard(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Tug"}}})
+	_, playerErr := gameInBattle(1, CreateGrid()).Board(0)
+
+	//Assert
+	if !errors.Is(sizeErr, ErrInvalidBoardSize) {
+		t.Errorf("got %v, want %v", sizeErr, ErrInvalidBoardSize)
+	}
+	if !errors.Is(fleetErr, ErrInvalidFleet) {
+		t.Errorf("got %v, want %v", fleetErr, ErrInvalidFleet)
+	}
+	if !errors.Is(playerErr, ErrInvalidPlayer) {
+		t.Errorf("got %v, want %v", playerErr, ErrInvalidPlayer)
+	}
+}
@@ -0,0 +1,165 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Orientation int
+
+const (
+	Horizontal Orientation = iota
+	Vertical
+)
+
+type ShipClass struct {
+	Name   string
+	Length int
+	Count  int
+}
+
+type FleetSpec []ShipClass
+
+var DefaultFleet = FleetSpec{
+	{Name: "Battleship", Length: 1, Count: 9},
+}
+
+var ClassicFleet = FleetSpec{
+	{Name: "Carrier", Length: 5, Count: 1},
+	{Name: "Battleship", Length: 4, Count: 1},
+	{Name: "Cruiser", Length: 3, Count: 1},
+	{Name: "Submarine", Length: 3, Count: 1},
+	{Name: "Destroyer", Length: 2, Count: 1},
+}
+
+var TournamentFleet = FleetSpec{
+	{Name: "Carrier", Length: 5, Count: 2},
+	{Name: "Battleship", Length: 4, Count: 2},
+	{Name: "Cruiser", Length: 3, Count: 2},
+	{Name: "Submarine", Length: 3, Count: 2},
+	{Name: "Destroyer", Length: 2, Count: 2},
+}
+
+var MorskoiBoiFleet = FleetSpec{
+	{Name: "Battleship", Length: 4, Count: 1},
+	{Name: "Cruiser", Length: 3, Count: 2},
+	{Name: "Destroyer", Length: 2, Count: 3},
+	{Name: "Boat", Length: 1, Count: 4},
+}
+
+type Ship struct {
+	Class       string
+	Length      int
+	Row         int
+	Col         int
+	Orientation Orientation
+	Hits        int
+}
+
+type ShipPlacement struct {
+	Class       string
+	Row         int
+	Col         int
+	Orientation Orientation
+}
+
+type FleetLayout []ShipPlacement
+
+func (ship Ship) Sunk() bool {
+	return ship.Hits >= ship.Length
+}
+
+func (fleet FleetSpec) TotalShips() int {
+	total := 0
+	for _, shipClass := range fleet {
+		total += shipClass.Count
+	}
+	return total
+}
+
+func (fleet FleetSpec) class(name string) (ShipClass, bool) {
+	for _, shipClass := range fleet {
+		if shipClass.Name == name {
+			return shipClass, true
+		}
+	}
+	return ShipClass{}, false
+}
+
+func (fleet FleetSpec) validate() error {
+	seen := map[string]bool{}
+	for _, shipClass := range fleet {
+		if shipClass.Name == "" {
+			return fmt.Errorf("%w: ship class has no name", ErrInvalidFleet)
+		}
+		if seen[shipClass.Name] {
+			return fmt.Errorf("%w: ship class %s listed twice", ErrInvalidFleet, shipClass.Name)
+		}
+		if shipClass.Length < 1 {
+			return fmt.Errorf("%w: %s length = %d, want at least 1", ErrInvalidFleet, shipClass.Name, shipClass.Length)
+		}
+		if shipClass.Count < 0 {
+			return fmt.Errorf("%w: %s count = %d, want at least 0", ErrInvalidFleet, shipClass.Name, shipClass.Count)
+		}
+		seen[shipClass.Name] = true
+	}
+	return nil
+}
+
+// CheckFleet reports every ship class the player has not finished placing.
+func CheckFleet(board Board) error {
+	var fleetErrs []error
+	for _, shipClass := range board.rules.Fleet {
+		placed := countOfShipsOfClass(board, shipClass.Name)
+		if placed < shipClass.Count {
+			fleetErrs = append(fleetErrs, &FleetError{Class: shipClass.Name, Placed: placed, Count: shipClass.Count})
+		}
+	}
+	return errors.Join(fleetErrs...)
+}
+
+// PlaceFleet places a whole fleet at once. The layout must complete the
+// board's fleet; if anything is wrong the board is returned unchanged along
+// with every problem found.
+func PlaceFleet(board Board, layout FleetLayout) (Board, error) {
+	var layoutErrs []error
+	placedBoard := board
+	for i, placement := range layout {
+		var placeErr error
+		placedBoard, placeErr = PlaceShip(placedBoard, placement.Class, placement.Row, placement.Col, placement.Orientation)
+		if placeErr != nil {
+			layoutErrs = append(layoutErrs, fmt.Errorf("ship %d (%s): %w", i+1, placement.Class, placeErr))
+		}
+	}
+
+	fleetErr := CheckFleet(placedBoard)
+	if fleetErr != nil {
+		layoutErrs = append(layoutErrs, fleetErr)
+	}
+	if len(layoutErrs) > 0 {
+		return board, errors.Join(layoutErrs...)
+	}
+	return placedBoard, nil
+}
+
+func (ship Ship) squares() [][2]int {
+	squares := make([][2]int, ship.Length)
+	for i := range squares {
+		if ship.Orientation == Vertical {
+			squares[i] = [2]int{ship.Row + i, ship.Col}
+		} else {
+			squares[i] = [2]int{ship.Row, ship.Col + i}
+		}
+	}
+	return squares
+}
+
+func countOfShipsOfClass(board Board, class string) int {
+	shipCount := 0
+	for _, placed := range board.ships {
+		if placed.Class == class {
+			shipCount++
+		}
+	}
+	return shipCount
+}
@@ -0,0 +1,199 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCannotPlaceShipOfUnknownClass(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	_, got := PlaceShip(board, "Frigate", 2, 2, Horizontal)
+
+	//Assert
+	want := errors.New(`unknown ship class: "Frigate" is not in the fleet`)
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestCannotPlaceMoreShipsOfClassThanFleetHas(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	boardWithCruiser, _ := PlaceShip(board, "Cruiser", 0, 0, Horizontal)
+
+	//Act
+	got, err := PlaceShip(boardWithCruiser, "Cruiser", 2, 0, Horizontal)
+
+	//Assert
+	want := errors.New("too many ships of class Cruiser: fleet has 1")
+	if err == nil || err.Error() != want.Error() {
+		t.Errorf("got %v, want %v", err, want)
+	}
+	if len(got.Ships()) != 1 {
+		t.Errorf("got %d ships, want 1", len(got.Ships()))
+	}
+}
+
+func TestShipsOfSameLengthCountAgainstTheirOwnClass(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	boardWithCruiser, _ := PlaceShip(board, "Cruiser", 0, 0, Horizontal)
+
+	//Act
+	_, got := PlaceShip(boardWithCruiser, "Submarine", 2, 0, Horizontal)
+
+	//Assert
+	if got != nil {
+		t.Errorf("got %v, want no error", got)
+	}
+}
+
+func TestCheckFleetReportsMissingShipClasses(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Carrier", 0, 0, Horizontal)
+	board, _ = PlaceShip(board, "Battleship", 2, 0, Horizontal)
+	board, _ = PlaceShip(board, "Cruiser", 4, 0, Horizontal)
+
+	//Act
+	got := CheckFleet(board)
+
+	//Assert
+	want := errors.New("too few ships of class Submarine: placed 0, fleet has 1\ntoo few ships of class Destroyer: placed 0, fleet has 1")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestCheckFleetAcceptsCompleteFleet(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Carrier", 0, 0, Horizontal)
+	board, _ = PlaceShip(board, "Battleship", 2, 0, Horizontal)
+	board, _ = PlaceShip(board, "Cruiser", 4, 0, Horizontal)
+	board, _ = PlaceShip(board, "Submarine", 6, 0, Horizontal)
+	board, _ = PlaceShip(board, "Destroyer", 8, 0, Horizontal)
+
+	//Act
+	got := CheckFleet(board)
+
+	//Assert
+	if got != nil {
+		t.Errorf("got %v, want no error", got)
+	}
+}
+
+func TestCannotCreateBoardWithInvalidFleet(t *testing.T) {
+	type fleet struct {
+		spec      FleetSpec
+		errorText string
+	}
+	fleets := []fleet{
+		{spec: FleetSpec{{Name: "", Length: 2, Count: 1}}, errorText: "invalid fleet: ship class has no name"},
+		{spec: FleetSpec{{Name: "Tug", Length: 0, Count: 1}}, errorText: "invalid fleet: Tug length = 0, want at least 1"},
+		{spec: FleetSpec{{Name: "Tug", Length: 1, Count: -1}}, errorText: "invalid fleet: Tug count = -1, want at least 0"},
+		{spec: FleetSpec{{Name: "Tug", Length: 1, Count: 1}, {Name: "Tug", Length: 2, Count: 1}}, errorText: "invalid fleet: ship class Tug listed twice"},
+	}
+
+	for _, fleet := range fleets {
+		//act
+		_, got := CreateBoard(Rules{Width: 7, Height: 7, Fleet: fleet.spec})
+
+		//assert
+		want := errors.New(fleet.errorText)
+		if got == nil || got.Error() != want.Error() {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+var classicLayout = FleetLayout{
+	{Class: "Carrier", Row: 0, Col: 0, Orientation: Horizontal},
+	{Class: "Battleship", Row: 2, Col: 0, Orientation: Horizontal},
+	{Class: "Cruiser", Row: 4, Col: 0, Orientation: Horizontal},
+	{Class: "Submarine", Row: 6, Col: 0, Orientation: Horizontal},
+	{Class: "Destroyer", Row: 8, Col: 0, Orientation: Horizontal},
+}
+
+func TestPlaceFleetPlacesWholeLayout(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	got, err := PlaceFleet(board, classicLayout)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if len(got.Ships()) != 5 || countOfShipsOnGrid(got) != 17 {
+		t.Errorf("got %d ships on %d squares, want 5 ships on 17 squares", len(got.Ships()), countOfShipsOnGrid(got))
+	}
+}
+
+func TestPlaceFleetReportsEveryProblem(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	layout := FleetLayout{
+		{Class: "Carrier", Row: 0, Col: 6, Orientation: Horizontal},
+		{Class: "Battleship", Row: 2, Col: 0, Orientation: Horizontal},
+		{Class: "Cruiser", Row: 2, Col: 1, Orientation: Vertical},
+		{Class: "Destroyer", Row: 8, Col: 0, Orientation: Horizontal},
+		{Class: "Destroyer", Row: 8, Col: 5, Orientation: Horizontal},
+	}
+
+	//Act
+	got, err := PlaceFleet(board, layout)
+
+	//Assert
+	want := errors.New("ship 1 (Carrier): K1 is off the grid, want A1 to J10\n" +
+		"ship 3 (Cruiser): ship already placed at B3\n" +
+		"ship 5 (Destroyer): too many ships of class Destroyer: fleet has 1\n" +
+		"too few ships of class Carrier: placed 0, fleet has 1\n" +
+		"too few ships of class Cruiser: placed 0, fleet has 1\n" +
+		"too few ships of class Submarine: placed 0, fleet has 1")
+	if err == nil || err.Error() != want.Error() {
+		t.Errorf("got %v, want %v", err, want)
+	}
+	if !errors.Is(err, ErrOutOfBounds) || !errors.Is(err, ErrCellOccupied) || !errors.Is(err, ErrFleetFull) || !errors.Is(err, ErrFleetIncomplete) {
+		t.Errorf("got %v, want every problem to be matchable", err)
+	}
+	if len(got.Ships()) != 0 {
+		t.Errorf("got %d ships, want the board left empty", len(got.Ships()))
+	}
+}
+
+func TestPlaceFleetRejectsIncompleteLayout(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	got, err := PlaceFleet(board, classicLayout[:4])
+
+	//Assert
+	if !errors.Is(err, ErrFleetIncomplete) {
+		t.Errorf("got %v, want %v", err, ErrFleetIncomplete)
+	}
+	if len(got.Ships()) != 0 {
+		t.Errorf("got %d ships, want the board left empty", len(got.Ships()))
+	}
+}
+
+func TestGamePlaceFleetOnlyDuringSetup(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(ClassicRules)
+	game.PlaceFleet(1, classicLayout)
+	game.PlaceFleet(2, classicLayout)
+	game.StartBattle()
+
+	//Act
+	got := game.PlaceFleet(1, classicLayout)
+
+	//Assert
+	if !errors.Is(got, ErrWrongPhase) {
+		t.Errorf("got %v, want %v", got, ErrWrongPhase)
+	}
+}
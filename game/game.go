@@ -5,95 +5,388 @@ import (
 	"fmt"
 )
 
-var hit = "Hit"
-var miss = "Miss"
-var ship = "Ship"
+type Phase int
 
-func CreateGrid() (grid [7][7]string) {
-	return [7][7]string{}
+const (
+	PhaseSetup Phase = iota
+	PhaseBattle
+	PhaseFinished
+)
+
+func (phase Phase) String() string {
+	switch phase {
+	case PhaseSetup:
+		return "Setup"
+	case PhaseBattle:
+		return "Battle"
+	case PhaseFinished:
+		return "Finished"
+	}
+	return "Unknown"
 }
 
-func PlaceShip(grid [7][7]string, row int, col int) ([7][7]string, error) {
-	maxShip := 9
-	coordErr := areCoordinatesOnPlayingGrid(row, col)
+type Game struct {
+	rules         Rules
+	boards        [2]Board
+	currentPlayer int
+	phase         Phase
+	winner        int
+}
 
-	if coordErr != nil {
-		return grid, coordErr
+func NewGame(rules Rules) (*Game, error) {
+	if rules.Fleet.TotalShips() == 0 {
+		return nil, fmt.Errorf("%w: fleet has no ships", ErrInvalidFleet)
+	}
+
+	game := &Game{rules: rules, currentPlayer: 1, phase: PhaseSetup}
+	for i := range game.boards {
+		board, boardErr := CreateBoard(rules)
+		if boardErr != nil {
+			return nil, boardErr
+		}
+		game.boards[i] = board
 	}
+	return game, nil
+}
+
+func (game *Game) Rules() Rules {
+	return game.rules
+}
+
+func (game *Game) Phase() Phase {
+	return game.phase
+}
+
+func (game *Game) CurrentPlayer() int {
+	return game.currentPlayer
+}
+
+// Winner is 0 until the game is finished.
+func (game *Game) Winner() int {
+	return game.winner
+}
 
-	if grid[row][col] == ship {
-		return grid, fmt.Errorf("ship already placed at coordinates row: %d and column: %d", row, col)
+func (game *Game) Board(player int) (Board, error) {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return Board{}, playerErr
 	}
+	return game.boards[player-1], nil
+}
 
-	shipCount := countOfShipsOnGrid(grid)
-	if shipCount == maxShip {
-		return grid, errors.New("too many ships")
+func (game *Game) PlaceShip(player int, class string, row int, col int, orientation Orientation) error {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return playerErr
+	}
+	if game.phase == PhaseFinished {
+		return game.gameOverErr()
+	}
+	if game.phase != PhaseSetup {
+		return fmt.Errorf("%w: cannot place ships in the %s phase", ErrWrongPhase, game.phase)
 	}
 
-	grid[row][col] = ship
-	return grid, nil
+	board, placeErr := PlaceShip(game.boards[player-1], class, row, col, orientation)
+	if placeErr != nil {
+		return placeErr
+	}
+	game.boards[player-1] = board
+	return nil
 }
 
-func CurrentPlayerTakeShot(player int, grid [7][7]string, row int, col int) (int, string, bool, error) {
-	gridAfterShot, coordErr, shotResult := shootOpponent(grid, row, col)
+func (game *Game) PlaceFleet(player int, layout FleetLayout) error {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return playerErr
+	}
+	if game.phase == PhaseFinished {
+		return game.gameOverErr()
+	}
+	if game.phase != PhaseSetup {
+		return fmt.Errorf("%w: cannot place ships in the %s phase", ErrWrongPhase, game.phase)
+	}
 
-	if coordErr != nil {
-		return player, shotResult, false, coordErr
+	board, layoutErr := PlaceFleet(game.boards[player-1], layout)
+	if layoutErr != nil {
+		return layoutErr
 	}
+	game.boards[player-1] = board
+	return nil
+}
 
-	newPlayer := changePlayer(player)
+func (game *Game) StartBattle() error {
+	if game.phase == PhaseFinished {
+		return game.gameOverErr()
+	}
+	if game.phase != PhaseSetup {
+		return fmt.Errorf("%w: cannot start the battle in the %s phase", ErrWrongPhase, game.phase)
+	}
 
-	if shotResult == hit {
-		gameResult := HasPlayerWon(gridAfterShot)
-		return newPlayer, shotResult, gameResult, coordErr
+	var readyErrs []error
+	for player := 1; player <= len(game.boards); player++ {
+		readyErr := game.CheckReady(player)
+		if readyErr != nil {
+			readyErrs = append(readyErrs, readyErr)
+		}
+	}
+	if len(readyErrs) > 0 {
+		return errors.Join(readyErrs...)
 	}
 
-	return newPlayer, shotResult, false, coordErr
+	game.phase = PhaseBattle
+	return nil
 }
 
-func HasPlayerWon(grid [7][7]string) bool {
-	numberOfShips := countOfShipsOnGrid(grid)
-	if numberOfShips != 0 {
-		return false
+// CheckReady reports the ship classes a player still has to place.
+func (game *Game) CheckReady(player int) error {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return playerErr
+	}
+	fleetErr := CheckFleet(game.boards[player-1])
+	if fleetErr != nil {
+		return fmt.Errorf("player %d is not ready: %w", player, fleetErr)
 	}
-	return true
+	return nil
 }
 
-func shootOpponent(grid [7][7]string, row int, col int) ([7][7]string, error, string) {
-	coordErr := areCoordinatesOnPlayingGrid(row, col)
+func PlaceShip(board Board, class string, row int, col int, orientation Orientation) (Board, error) {
+	newShip, placeErr := checkPlacement(board, class, row, col, orientation)
+	if placeErr != nil {
+		return board, placeErr
+	}
 
-	if coordErr != nil {
-		return grid, coordErr, miss
+	board = board.clone()
+	for _, square := range newShip.squares() {
+		board.cells[square[0]][square[1]] = CellShip
+	}
+	board.ships = append(board.ships, newShip)
+	return board, nil
+}
+
+func checkPlacement(board Board, class string, row int, col int, orientation Orientation) (Ship, error) {
+	shipClass, known := board.rules.Fleet.class(class)
+	if !known {
+		return Ship{}, fmt.Errorf("%w: %q is not in the fleet", ErrUnknownShipClass, class)
+	}
+	if orientation != Horizontal && orientation != Vertical {
+		return Ship{}, fmt.Errorf("%w: %d", ErrInvalidOrientation, orientation)
 	}
 
-	if grid[row][col] == ship {
-		grid[row][col] = ""
-		return grid, nil, hit
+	newShip := Ship{Class: class, Length: shipClass.Length, Row: row, Col: col, Orientation: orientation}
+	for _, square := range newShip.squares() {
+		coordErr := areCoordinatesOnPlayingGrid(board, square[0], square[1])
+		if coordErr != nil {
+			return Ship{}, coordErr
+		}
+	}
+
+	for _, square := range newShip.squares() {
+		if board.cells[square[0]][square[1]] == CellShip {
+			return Ship{}, fmt.Errorf("%w at %s", ErrCellOccupied, Coord{Row: square[0], Col: square[1]})
+		}
+	}
+
+	if board.rules.NoTouching {
+		touchErr := checkNotTouching(board, newShip)
+		if touchErr != nil {
+			return Ship{}, touchErr
+		}
 	}
 
-	return grid, nil, miss
+	placed := countOfShipsOfClass(board, class)
+	if placed >= shipClass.Count {
+		return Ship{}, &FleetError{Class: class, Placed: placed, Count: shipClass.Count}
+	}
+	return newShip, nil
 }
 
-func areCoordinatesOnPlayingGrid(row int, col int) error {
-	if row < 0 || row > 6 {
-		return fmt.Errorf("invalid row value: row = %d, want between 0 & 6 ", row)
+func checkNotTouching(board Board, newShip Ship) error {
+	for _, square := range newShip.squares() {
+		for row := square[0] - 1; row <= square[0]+1; row++ {
+			for col := square[1] - 1; col <= square[1]+1; col++ {
+				if areCoordinatesOnPlayingGrid(board, row, col) != nil {
+					continue
+				}
+				neighbour := board.shipIndexAt(row, col)
+				if neighbour >= 0 {
+					return &TouchingError{Ship: board.ships[neighbour]}
+				}
+			}
+		}
 	}
-	if col < 0 || col > 6 {
-		return fmt.Errorf("invalid column value: column = %d, want between 0 & 6 ", col)
+	return nil
+}
+
+func (game *Game) CurrentPlayerTakeShot(player int, row int, col int) (ShotResult, error) {
+	rejected := ShotResult{Row: row, Col: col, Outcome: OutcomeMiss, NextPlayer: game.currentPlayer, GameOver: game.phase == PhaseFinished}
+
+	turnErr := game.checkTurn(player)
+	if turnErr != nil {
+		return rejected, turnErr
+	}
+	if game.rules.Mode == ModeSalvo {
+		return rejected, fmt.Errorf("%w: fire a salvo in the %s mode", ErrWrongMode, game.rules.Mode)
+	}
+
+	opponent := changePlayer(player)
+	boardAfterShot, result, shotErr := fireShot(game.boards[opponent-1], row, col)
+
+	if shotErr != nil {
+		return rejected, shotErr
+	}
+
+	game.boards[opponent-1] = boardAfterShot
+	game.endTurn(player, result.GameOver)
+	if game.rules.TurnPolicy == TurnPolicyShootAgainOnHit && result.Outcome != OutcomeMiss && !result.GameOver {
+		game.currentPlayer = player
+	}
+	result.NextPlayer = game.currentPlayer
+
+	return result, nil
+}
+
+// CurrentPlayerTakeSalvo fires one shot for each of the player's ships still
+// afloat. Every shot is checked before any is fired, so a bad volley leaves
+// the game untouched and the turn with the player.
+func (game *Game) CurrentPlayerTakeSalvo(player int, shots []Coord) ([]ShotResult, error) {
+	turnErr := game.checkTurn(player)
+	if turnErr != nil {
+		return nil, turnErr
+	}
+	if game.rules.Mode != ModeSalvo {
+		return nil, fmt.Errorf("%w: fire single shots in the %s mode", ErrWrongMode, game.rules.Mode)
+	}
+
+	salvoSize := countOfShipsAfloat(game.boards[player-1])
+	if len(shots) != salvoSize {
+		return nil, fmt.Errorf("%w: salvo has %d shots, want %d", ErrSalvoSize, len(shots), salvoSize)
+	}
+
+	opponent := changePlayer(player)
+	boardAfterSalvo := game.boards[opponent-1]
+	results := make([]ShotResult, len(shots))
+	won := false
+	for i, shot := range shots {
+		var shotErr error
+		boardAfterSalvo, results[i], shotErr = fireShot(boardAfterSalvo, shot.Row, shot.Col)
+		if shotErr != nil {
+			return nil, shotErr
+		}
+		won = won || results[i].GameOver
+	}
+
+	game.boards[opponent-1] = boardAfterSalvo
+	game.endTurn(player, won)
+	for i := range results {
+		results[i].NextPlayer = game.currentPlayer
+	}
+
+	return results, nil
+}
+
+func (game *Game) checkTurn(player int) error {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return playerErr
+	}
+	if game.phase == PhaseFinished {
+		return game.gameOverErr()
+	}
+	if game.phase != PhaseBattle {
+		return fmt.Errorf("%w: cannot shoot in the %s phase", ErrWrongPhase, game.phase)
+	}
+	if player != game.currentPlayer {
+		return fmt.Errorf("%w: it is player %d's turn", ErrNotYourTurn, game.currentPlayer)
 	}
 	return nil
 }
 
-func countOfShipsOnGrid(grid [7][7]string) int {
-	shipCount := 0
-	for _, row := range grid {
-		for _, coordinates := range row {
-			if coordinates == ship {
-				shipCount++
-			}
+func (game *Game) endTurn(player int, won bool) {
+	game.currentPlayer = changePlayer(player)
+	if won {
+		game.phase = PhaseFinished
+		game.winner = player
+	}
+}
+
+func fireShot(board Board, row int, col int) (Board, ShotResult, error) {
+	boardAfterShot, shotErr, outcome, sunkShip := shootOpponent(board, row, col)
+	if shotErr != nil {
+		return board, ShotResult{}, shotErr
+	}
+
+	result := ShotResult{
+		Row:            row,
+		Col:            col,
+		Outcome:        outcome,
+		SunkShip:       sunkShip,
+		ShipsRemaining: countOfShipsAfloat(boardAfterShot),
+		GameOver:       outcome == OutcomeSunk && HasPlayerWon(boardAfterShot),
+	}
+	return boardAfterShot, result, nil
+}
+
+// HasPlayerWon reports whether every ship in the board's fleet has been sunk.
+// A board that never had its fleet placed cannot be beaten.
+func HasPlayerWon(board Board) bool {
+	fleetSize := board.rules.Fleet.TotalShips()
+	if fleetSize == 0 {
+		return false
+	}
+	return countOfShipsSunk(board) == fleetSize
+}
+
+// shootOpponent also names the ship when the shot sinks it.
+func shootOpponent(board Board, row int, col int) (Board, error, Outcome, string) {
+	coordErr := areCoordinatesOnPlayingGrid(board, row, col)
+
+	if coordErr != nil {
+		return board, coordErr, OutcomeMiss, ""
+	}
+
+	switch board.cells[row][col] {
+	case CellShip:
+		board = board.clone()
+		board.cells[row][col] = CellHit
+		struck := board.shipIndexAt(row, col)
+		board.ships[struck].Hits++
+		if board.ships[struck].Sunk() {
+			return board, nil, OutcomeSunk, board.ships[struck].Class
 		}
+		return board, nil, OutcomeHit, ""
+	case CellHit, CellMiss:
+		return board, fmt.Errorf("%w at %s", ErrAlreadyShot, Coord{Row: row, Col: col}), OutcomeMiss, ""
 	}
-	return shipCount
+
+	board = board.clone()
+	board.cells[row][col] = CellMiss
+	return board, nil, OutcomeMiss, ""
+}
+
+func countOfShipsAfloat(board Board) int {
+	afloat := 0
+	for _, placed := range board.ships {
+		if !placed.Sunk() {
+			afloat++
+		}
+	}
+	return afloat
+}
+
+func countOfShipsSunk(board Board) int {
+	return len(board.ships) - countOfShipsAfloat(board)
+}
+
+func (game *Game) gameOverErr() error {
+	return fmt.Errorf("%w: player %d has won", ErrGameOver, game.winner)
+}
+
+func isValidPlayer(player int) error {
+	if player != 1 && player != 2 {
+		return fmt.Errorf("%w: player = %d, want 1 or 2", ErrInvalidPlayer, player)
+	}
+	return nil
 }
 
 func changePlayer(player int) int {
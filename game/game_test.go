@@ -2,6 +2,7 @@ package game
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -12,13 +13,13 @@ func TestCreateGrid(t *testing.T) {
 	expectedRows := 7
 
 	//Assert
-	GridSizeCols := len(grid)
+	GridSizeCols := grid.Width()
 	if GridSizeCols != expectedCols {
 		t.Errorf("Grid has wrong number of columns. Expected %d but was %d", expectedCols, GridSizeCols)
 		//t.Errorf to allow error message with values %v.
 	}
 
-	GridSizeRows := len(grid[0])
+	GridSizeRows := grid.Height()
 	if GridSizeRows != expectedRows {
 		t.Errorf("Grid has wrong number of rows. Expected %d, but was %d", expectedRows, GridSizeRows)
 	}
@@ -27,13 +28,13 @@ func TestCreateGrid(t *testing.T) {
 func TestCannotPlaceShipOnAShip(t *testing.T) {
 	// Arrange
 	grid := CreateGrid()
-	gridWithShip, _ := PlaceShip(grid, 3, 6)
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 3, 6, Horizontal)
 
 	//Act
-	_, shipErr := PlaceShip(gridWithShip, 3, 6)
+	_, shipErr := PlaceShip(gridWithShip, "Battleship", 3, 6, Horizontal)
 
 	//Assert
-	want := errors.New("ship already placed at coordinates row: 3 and column: 6")
+	want := errors.New("ship already placed at G4")
 
 	if shipErr.Error() != want.Error() {
 		t.Errorf("wanted %v got %v", want, shipErr)
@@ -43,13 +44,13 @@ func TestCannotPlaceShipOnAShip(t *testing.T) {
 func TestCannotPlaceShipOnAShipReportCoordinates(t *testing.T) {
 	// Arrange
 	grid := CreateGrid()
-	gridWithShip, _ := PlaceShip(grid, 1, 5)
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 1, 5, Horizontal)
 
 	//Act
-	_, shipErr := PlaceShip(gridWithShip, 1, 5)
+	_, shipErr := PlaceShip(gridWithShip, "Battleship", 1, 5, Horizontal)
 
 	//Assert
-	want := errors.New("ship already placed at coordinates row: 1 and column: 5")
+	want := errors.New("ship already placed at F2")
 
 	if shipErr.Error() != want.Error() {
 		t.Errorf("wanted %v got %v", want, shipErr)
@@ -59,21 +60,21 @@ func TestCannotPlaceShipOnAShipReportCoordinates(t *testing.T) {
 func TestCannotPlaceTenthShip(t *testing.T) {
 	//Arrange (set things up)
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
 
 	//Act
-	_, got := PlaceShip(gridWith9Ships, 3, 5)
+	_, got := PlaceShip(gridWith9Ships, "Battleship", 3, 5, Horizontal)
 
 	//Assert
-	want := errors.New("too many ships")
+	want := errors.New("too many ships of class Battleship: fleet has 9")
 
 	if got.Error() != want.Error() {
 		t.Errorf("Got %v want %v", got, want)
@@ -83,23 +84,23 @@ func TestCannotPlaceTenthShip(t *testing.T) {
 func TestPlacingTenthShipDoesNotChangeGrid(t *testing.T) {
 	//Arrange (set things up)
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
 
 	//Act
-	got, _ := PlaceShip(gridWith9Ships, 3, 5)
+	got, _ := PlaceShip(gridWith9Ships, "Battleship", 3, 5, Horizontal)
 
 	//Assert
 	want := gridWith9Ships
 
-	if got != want {
+	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Got %v want %v", got, want)
 	}
 }
@@ -111,10 +112,10 @@ func TestCannotPlaceShipOutsideGrid(t *testing.T) {
 		errorText string
 	}
 	shipCoordinates := []coordinates{
-		{row: 7, col: 6, errorText: "invalid row value: row = 7, want between 0 & 6 "},
-		{row: -1, col: 0, errorText: "invalid row value: row = -1, want between 0 & 6 "},
-		{row: 0, col: -1, errorText: "invalid column value: column = -1, want between 0 & 6 "},
-		{row: 6, col: 7, errorText: "invalid column value: column = 7, want between 0 & 6 "},
+		{row: 7, col: 6, errorText: "G8 is off the grid, want A1 to G7"},
+		{row: -1, col: 0, errorText: "row -1 column 0 is off the grid, want A1 to G7"},
+		{row: 0, col: -1, errorText: "row 0 column -1 is off the grid, want A1 to G7"},
+		{row: 6, col: 7, errorText: "H7 is off the grid, want A1 to G7"},
 	}
 
 	//Act (run the code you want to do the thing)
@@ -123,7 +124,7 @@ func TestCannotPlaceShipOutsideGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, got := PlaceShip(grid, coordinates.row, coordinates.col)
+		_, got := PlaceShip(grid, "Battleship", coordinates.row, coordinates.col, Horizontal)
 
 		//assert
 		want := errors.New(coordinates.errorText)
@@ -151,7 +152,7 @@ func TestCanPlaceShipAtEdgesOfGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, got := PlaceShip(grid, coordinates.row, coordinates.col)
+		_, got := PlaceShip(grid, "Battleship", coordinates.row, coordinates.col, Horizontal)
 
 		//assert
 		if got != nil {
@@ -178,11 +179,11 @@ func TestCannotPlaceShipOutsideGridDoesntChangeGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		got, _ := PlaceShip(grid, coordinates.row, coordinates.col)
+		got, _ := PlaceShip(grid, "Battleship", coordinates.row, coordinates.col, Horizontal)
 
 		//assert
 		want := grid
-		if got != want {
+		if !reflect.DeepEqual(got, want) {
 			t.Errorf("got %v, want %v", got, want)
 		}
 	}
@@ -190,32 +191,162 @@ func TestCannotPlaceShipOutsideGridDoesntChangeGrid(t *testing.T) {
 
 func TestReportsShipBeingHit(t *testing.T) {
 	//Arrange
-	grid := CreateGrid()
-	shipOnGrid, _ := PlaceShip(grid, 1, 2)
+	grid, _ := CreateBoard(ClassicRules)
+	shipOnGrid, _ := PlaceShip(grid, "Cruiser", 1, 2, Horizontal)
 
 	//Act
-	_, _, got := shootOpponent(shipOnGrid, 1, 2)
+	_, _, got, _ := shootOpponent(shipOnGrid, 1, 2)
 
 	//Assert
-	want := "Hit"
+	want := OutcomeHit
 	if got != want {
 		t.Errorf("shot did not report a hit ship, got %v want %v", got, want)
 	}
 }
 
-func TestShipCannotBeShotTwice(t *testing.T) {
+func TestReportsShipBeingSunkWithItsName(t *testing.T) {
+	//Arrange
+	grid, _ := CreateBoard(ClassicRules)
+	shipOnGrid, _ := PlaceShip(grid, "Cruiser", 1, 2, Vertical)
+	gridWith1Hit, _, _, _ := shootOpponent(shipOnGrid, 1, 2)
+	gridWith2Hits, _, _, _ := shootOpponent(gridWith1Hit, 3, 2)
+
+	//Act
+	_, _, got, sunkShip := shootOpponent(gridWith2Hits, 2, 2)
+
+	//Assert
+	want := OutcomeSunk
+	if got != want || sunkShip != "Cruiser" {
+		t.Errorf("got %v of %q, want %v of %q", got, sunkShip, want, "Cruiser")
+	}
+}
+
+func TestHitRecordsDamageOnStruckShipOnly(t *testing.T) {
+	//Arrange
+	grid, _ := CreateBoard(ClassicRules)
+	gridWith1Ship, _ := PlaceShip(grid, "Cruiser", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Submarine", 2, 2, Horizontal)
+
+	//Act
+	got, _, _, _ := shootOpponent(gridWith2Ships, 2, 3)
+
+	//Assert
+	ships := got.Ships()
+	if ships[0].Hits != 0 || ships[1].Hits != 1 {
+		t.Errorf("got Cruiser with %d hits and Submarine with %d hits, want 0 and 1", ships[0].Hits, ships[1].Hits)
+	}
+}
+
+func TestOneSquareShipIsSunkByOneHit(t *testing.T) {
 	//Arrange
 	grid := CreateGrid()
-	gridWithShip, _ := PlaceShip(grid, 1, 2)
-	gridWithSunkShip, _, _ := shootOpponent(gridWithShip, 1, 2)
+	shipOnGrid, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+
+	//Act
+	_, _, got, sunkShip := shootOpponent(shipOnGrid, 1, 2)
+
+	//Assert
+	want := OutcomeSunk
+	if got != want || sunkShip != "Battleship" {
+		t.Errorf("got %v of %q, want %v of %q", got, sunkShip, want, "Battleship")
+	}
+}
+
+func TestHasPlayerNotWonWhileDamagedShipIsAfloat(t *testing.T) {
+	//Arrange
+	grid, _ := CreateBoard(ClassicRules)
+	gridWithShip, _ := PlaceShip(grid, "Destroyer", 4, 4, Horizontal)
+	gridWithHit, _, _, _ := shootOpponent(gridWithShip, 4, 4)
 
 	//Act
-	_, _, got := shootOpponent(gridWithSunkShip, 1, 2)
+	got := HasPlayerWon(gridWithHit)
+
+	//Assert
+	want := false
+	if got != want {
+		t.Errorf("wanted %v got %v", want, got)
+	}
+}
 
+func TestHitIsRecordedOnGrid(t *testing.T) {
 	//Arrange
-	want := "Miss"
+	grid := CreateGrid()
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+
+	//Act
+	gridAfterShot, _, _, _ := shootOpponent(gridWithShip, 1, 2)
+
+	//Assert
+	got := gridAfterShot.cells[1][2]
+	want := CellHit
 	if got != want {
-		t.Errorf("shot was not a miss, got %v want %v", got, want)
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMissIsRecordedOnGrid(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+
+	//Act
+	gridAfterShot, _, _, _ := shootOpponent(grid, 4, 4)
+
+	//Assert
+	got := gridAfterShot.cells[4][4]
+	want := CellMiss
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestShootingDoesNotChangeOriginalGrid(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+
+	//Act
+	shootOpponent(gridWithShip, 1, 2)
+	shootOpponent(gridWithShip, 3, 3)
+
+	//Assert
+	if gridWithShip.cells[1][2] != CellShip || gridWithShip.cells[3][3] != CellEmpty {
+		t.Errorf("original grid was changed, got %v and %v", gridWithShip.cells[1][2], gridWithShip.cells[3][3])
+	}
+}
+
+func TestShipCannotBeShotTwice(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWithSunkShip, _, _, _ := shootOpponent(gridWithShip, 1, 2)
+
+	//Act
+	gridAfterSecondShot, got, _, _ := shootOpponent(gridWithSunkShip, 1, 2)
+
+	//Assert
+	want := errors.New("square already shot at C2")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if !errors.Is(got, ErrAlreadyShot) {
+		t.Errorf("got %v, want it to be ErrAlreadyShot", got)
+	}
+	if gridAfterSecondShot.cells[1][2] != CellHit {
+		t.Errorf("hit was forgotten, got %v want %v", gridAfterSecondShot.cells[1][2], CellHit)
+	}
+}
+
+func TestMissCannotBeShotTwice(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+	gridWithMiss, _, _, _ := shootOpponent(grid, 4, 4)
+
+	//Act
+	_, got, _, _ := shootOpponent(gridWithMiss, 4, 4)
+
+	//Assert
+	if !errors.Is(got, ErrAlreadyShot) {
+		t.Errorf("got %v, want it to be ErrAlreadyShot", got)
 	}
 }
 
@@ -227,10 +358,10 @@ func TestCannotShootOutsideGrid(t *testing.T) {
 	}
 
 	shotCoordinates := []coordinates{
-		{row: 7, col: 6, errorText: "invalid row value: row = 7, want between 0 & 6 "},
-		{row: -1, col: 0, errorText: "invalid row value: row = -1, want between 0 & 6 "},
-		{row: 0, col: -1, errorText: "invalid column value: column = -1, want between 0 & 6 "},
-		{row: 6, col: 7, errorText: "invalid column value: column = 7, want between 0 & 6 "},
+		{row: 7, col: 6, errorText: "G8 is off the grid, want A1 to G7"},
+		{row: -1, col: 0, errorText: "row -1 column 0 is off the grid, want A1 to G7"},
+		{row: 0, col: -1, errorText: "row 0 column -1 is off the grid, want A1 to G7"},
+		{row: 6, col: 7, errorText: "H7 is off the grid, want A1 to G7"},
 	}
 
 	for _, coordinates := range shotCoordinates {
@@ -238,7 +369,7 @@ func TestCannotShootOutsideGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, got, _ := shootOpponent(grid, coordinates.row, coordinates.col)
+		_, got, _, _ := shootOpponent(grid, coordinates.row, coordinates.col)
 
 		//assert
 		want := errors.New(coordinates.errorText)
@@ -266,10 +397,10 @@ func TestReportCanReportMissAtEdgesOfGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, _, got := shootOpponent(grid, coordinates.row, coordinates.col)
+		_, _, got, _ := shootOpponent(grid, coordinates.row, coordinates.col)
 
 		//assert
-		want := "Miss"
+		want := OutcomeMiss
 		if got != want {
 			t.Errorf("got %v, want %v", got, want)
 		}
@@ -294,10 +425,10 @@ func TestReportCanShootAtEdgesOfGrid(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, _, got := shootOpponent(grid, coordinates.row, coordinates.col)
+		_, _, got, _ := shootOpponent(grid, coordinates.row, coordinates.col)
 
 		//assert
-		want := "Miss"
+		want := OutcomeMiss
 		if got != want {
 			t.Errorf("got %v, want %v", got, want)
 		}
@@ -307,25 +438,25 @@ func TestReportCanShootAtEdgesOfGrid(t *testing.T) {
 func TestHasPlayerWon(t *testing.T) {
 	//Arrange (set things up)
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
-
-	gridWith1SunkShip, _, _ := shootOpponent(gridWith9Ships, 1, 2)
-	gridWith2SunkShips, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
-	gridWith3SunkShips, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
-	gridWith4SunkShips, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
-	gridWith5SunkShips, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
-	gridWith6SunkShips, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
-	gridWith7SunkShips, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
-	gridWith8SunkShips, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
-	gridWith9SunkShips, _, _ := shootOpponent(gridWith8SunkShips, 2, 4)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
+
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith9Ships, 1, 2)
+	gridWith2SunkShips, _, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
+	gridWith3SunkShips, _, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
+	gridWith4SunkShips, _, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
+	gridWith5SunkShips, _, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
+	gridWith6SunkShips, _, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
+	gridWith7SunkShips, _, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
+	gridWith8SunkShips, _, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
+	gridWith9SunkShips, _, _, _ := shootOpponent(gridWith8SunkShips, 2, 4)
 
 	//Act
 	got := HasPlayerWon(gridWith9SunkShips)
@@ -340,24 +471,24 @@ func TestHasPlayerWon(t *testing.T) {
 func TestHasPlayerNotWon(t *testing.T) {
 	//Arrange (set things up)
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
-
-	gridWith1SunkShip, _, _ := shootOpponent(gridWith9Ships, 1, 2)
-	gridWith2SunkShips, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
-	gridWith3SunkShips, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
-	gridWith4SunkShips, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
-	gridWith5SunkShips, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
-	gridWith6SunkShips, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
-	gridWith7SunkShips, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
-	gridWith8SunkShips, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
+
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith9Ships, 1, 2)
+	gridWith2SunkShips, _, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
+	gridWith3SunkShips, _, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
+	gridWith4SunkShips, _, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
+	gridWith5SunkShips, _, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
+	gridWith6SunkShips, _, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
+	gridWith7SunkShips, _, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
+	gridWith8SunkShips, _, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
 
 	//Act
 	got := HasPlayerWon(gridWith8SunkShips)
@@ -372,19 +503,55 @@ func TestHasPlayerNotWon(t *testing.T) {
 func TestPlayerHitsShipOnTurnAndReportsHit(t *testing.T) {
 	//Arrange
 	player := 1
-	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
+	grid, _ := CreateBoard(ClassicRules)
+	gridWith1Ship, _ := PlaceShip(grid, "Destroyer", 1, 2, Horizontal)
 
 	//Act
-	_, got, _, _ := CurrentPlayerTakeShot(player, gridWith1Ship, 1, 2)
+	result, _ := gameInBattle(player, gridWith1Ship).CurrentPlayerTakeShot(player, 1, 2)
+	got := result.Outcome
 
 	//Assert
-	want := "Hit"
+	want := OutcomeHit
 	if got != want {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
 
+func TestPlayerHitsLastSquareOfShipAndReportsSunk(t *testing.T) {
+	//Arrange
+	player := 1
+	grid, _ := CreateBoard(ClassicRules)
+	gridWith1Ship, _ := PlaceShip(grid, "Destroyer", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Cruiser", 5, 5, Vertical)
+	gridWithHit, _, _, _ := shootOpponent(gridWith2Ships, 1, 3)
+
+	//Act
+	got, _ := gameInBattle(player, gridWithHit).CurrentPlayerTakeShot(player, 1, 2)
+
+	//Assert
+	want := ShotResult{Row: 1, Col: 2, Outcome: OutcomeSunk, SunkShip: "Destroyer", ShipsRemaining: 1, NextPlayer: 2}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestShotResultReportsMissAndShipsRemaining(t *testing.T) {
+	//Arrange
+	player := 2
+	grid, _ := CreateBoard(ClassicRules)
+	gridWith1Ship, _ := PlaceShip(grid, "Destroyer", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Cruiser", 5, 5, Vertical)
+
+	//Act
+	got, _ := gameInBattle(player, gridWith2Ships).CurrentPlayerTakeShot(player, 8, 8)
+
+	//Assert
+	want := ShotResult{Row: 8, Col: 8, Outcome: OutcomeMiss, ShipsRemaining: 2, NextPlayer: 1}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
 func TestPlayerMissesShipOnTurnAndReportsMiss(t *testing.T) {
 	//Arrange
 	row := 1
@@ -393,10 +560,11 @@ func TestPlayerMissesShipOnTurnAndReportsMiss(t *testing.T) {
 	grid := CreateGrid()
 
 	//Act
-	_, got, _, _ := CurrentPlayerTakeShot(player, grid, row, col)
+	result, _ := gameInBattle(player, grid).CurrentPlayerTakeShot(player, row, col)
+	got := result.Outcome
 
 	//Assert
-	want := "Miss"
+	want := OutcomeMiss
 	if got != want {
 		t.Errorf("got %v, want %v", got, want)
 	}
@@ -408,10 +576,11 @@ func TestPlayerMissesGridOnTurnAndReportsInvalidShot(t *testing.T) {
 	grid := CreateGrid()
 
 	//Act
-	_, got, _, _ := CurrentPlayerTakeShot(player, grid, -1, 4)
+	result, _ := gameInBattle(player, grid).CurrentPlayerTakeShot(player, -1, 4)
+	got := result.Outcome
 
 	//Assert
-	want := "Miss"
+	want := OutcomeMiss
 	if got != want {
 		t.Errorf("got %v, want %v", got, want)
 	}
@@ -425,23 +594,46 @@ func TestTurnDoesntChangePlayerAfterInvalidShot(t *testing.T) {
 	grid := CreateGrid()
 
 	//Act
-	got, _, _, _ := CurrentPlayerTakeShot(player, grid, row, col)
+	result, _ := gameInBattle(player, grid).CurrentPlayerTakeShot(player, row, col)
+	got := result.NextPlayer
+
+	//Assert
+	want := 1
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTurnDoesntChangePlayerAfterRepeatShot(t *testing.T) {
+	//Arrange
+	player := 1
+	grid := CreateGrid()
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWithHit, _, _, _ := shootOpponent(gridWith1Ship, 1, 2)
+
+	//Act
+	result, err := gameInBattle(player, gridWithHit).CurrentPlayerTakeShot(player, 1, 2)
+	got := result.NextPlayer
 
 	//Assert
 	want := 1
 	if got != want {
 		t.Errorf("got %v, want %v", got, want)
 	}
+	if !errors.Is(err, ErrAlreadyShot) {
+		t.Errorf("got %v, want it to be ErrAlreadyShot", err)
+	}
 }
 
 func TestTurnDoesChangeFromPlayer1ToPlayer2AfterMissedShot(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
 
 	//Act
-	got, _, _, _ := CurrentPlayerTakeShot(player, gridWith1Ship, 3, 5)
+	result, _ := gameInBattle(player, gridWith1Ship).CurrentPlayerTakeShot(player, 3, 5)
+	got := result.NextPlayer
 
 	//Assert
 	want := 2
@@ -454,10 +646,11 @@ func TestTurnDoesChangeFromPlayer2ToPlayer1AfterMissedShot(t *testing.T) {
 	//Arrange
 	player := 2
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
 
 	//Act
-	got, _, _, _ := CurrentPlayerTakeShot(player, gridWith1Ship, 3, 5)
+	result, _ := gameInBattle(player, gridWith1Ship).CurrentPlayerTakeShot(player, 3, 5)
+	got := result.NextPlayer
 
 	//Assert
 	want := 1
@@ -470,10 +663,11 @@ func TestTurnDoesChangeFromPlayer2ToPlayer1AfterHitShot(t *testing.T) {
 	//Arrange
 	player := 2
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
 
 	//Act
-	got, _, _, _ := CurrentPlayerTakeShot(player, gridWith1Ship, 3, 5)
+	result, _ := gameInBattle(player, gridWith1Ship).CurrentPlayerTakeShot(player, 3, 5)
+	got := result.NextPlayer
 
 	//Assert
 	want := 1
@@ -486,10 +680,11 @@ func TestTurnDoesChangeFromPlayer1ToPlayer2AfterHitShot(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
 
 	//Act
-	got, _, _, _ := CurrentPlayerTakeShot(player, gridWith1Ship, 3, 5)
+	result, _ := gameInBattle(player, gridWith1Ship).CurrentPlayerTakeShot(player, 3, 5)
+	got := result.NextPlayer
 
 	//Assert
 	want := 2
@@ -502,27 +697,28 @@ func TestPlayerHitsShipOnTurnAndReportsWin(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
-
-	gridWith1SunkShip, _, _ := shootOpponent(gridWith9Ships, 1, 2)
-	gridWith2SunkShips, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
-	gridWith3SunkShips, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
-	gridWith4SunkShips, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
-	gridWith5SunkShips, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
-	gridWith6SunkShips, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
-	gridWith7SunkShips, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
-	gridWith8SunkShips, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
+
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith9Ships, 1, 2)
+	gridWith2SunkShips, _, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
+	gridWith3SunkShips, _, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
+	gridWith4SunkShips, _, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
+	gridWith5SunkShips, _, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
+	gridWith6SunkShips, _, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
+	gridWith7SunkShips, _, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
+	gridWith8SunkShips, _, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
 
 	//Act
-	_, _, got, _ := CurrentPlayerTakeShot(player, gridWith8SunkShips, 2, 4)
+	result, _ := gameInBattle(player, gridWith8SunkShips).CurrentPlayerTakeShot(player, 2, 4)
+	got := result.GameOver
 
 	//Assert
 	want := true
@@ -535,11 +731,12 @@ func TestPlayerHitsShipOnTurnAndDoesNotReportWin(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWithShip, _ := PlaceShip(grid, 2, 4)
-	gridWith2Ships, _ := PlaceShip(gridWithShip, 1, 3)
+	gridWithShip, _ := PlaceShip(grid, "Battleship", 2, 4, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWithShip, "Battleship", 1, 3, Horizontal)
 
 	//Act
-	_, _, got, _ := CurrentPlayerTakeShot(player, gridWith2Ships, 2, 4)
+	result, _ := gameInBattle(player, gridWith2Ships).CurrentPlayerTakeShot(player, 2, 4)
+	got := result.GameOver
 
 	//Assert
 	want := false
@@ -552,27 +749,28 @@ func TestPlayerMissesShipWithValidShotAndDoesNotReportWin(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
-
-	gridWith1SunkShip, _, _ := shootOpponent(gridWith9Ships, 1, 2)
-	gridWith2SunkShips, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
-	gridWith3SunkShips, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
-	gridWith4SunkShips, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
-	gridWith5SunkShips, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
-	gridWith6SunkShips, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
-	gridWith7SunkShips, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
-	gridWith8SunkShips, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
+
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith9Ships, 1, 2)
+	gridWith2SunkShips, _, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
+	gridWith3SunkShips, _, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
+	gridWith4SunkShips, _, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
+	gridWith5SunkShips, _, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
+	gridWith6SunkShips, _, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
+	gridWith7SunkShips, _, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
+	gridWith8SunkShips, _, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
 
 	//Act
-	_, _, got, _ := CurrentPlayerTakeShot(player, gridWith8SunkShips, 2, 6)
+	result, _ := gameInBattle(player, gridWith8SunkShips).CurrentPlayerTakeShot(player, 2, 6)
+	got := result.GameOver
 
 	//Assert
 	want := false
@@ -585,27 +783,28 @@ func TestPlayerDoesNotWinGameWithInvalidShotOnTurn(t *testing.T) {
 	//Arrange
 	player := 1
 	grid := CreateGrid()
-	gridWith1Ship, _ := PlaceShip(grid, 1, 2)
-	gridWith2Ships, _ := PlaceShip(gridWith1Ship, 2, 3)
-	gridWith3Ships, _ := PlaceShip(gridWith2Ships, 3, 4)
-	gridWith4Ships, _ := PlaceShip(gridWith3Ships, 4, 5)
-	gridWith5Ships, _ := PlaceShip(gridWith4Ships, 5, 6)
-	gridWith6Ships, _ := PlaceShip(gridWith5Ships, 6, 4)
-	gridWith7Ships, _ := PlaceShip(gridWith6Ships, 5, 1)
-	gridWith8Ships, _ := PlaceShip(gridWith7Ships, 1, 3)
-	gridWith9Ships, _ := PlaceShip(gridWith8Ships, 2, 4)
-
-	gridWith1SunkShip, _, _ := shootOpponent(gridWith9Ships, 1, 2)
-	gridWith2SunkShips, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
-	gridWith3SunkShips, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
-	gridWith4SunkShips, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
-	gridWith5SunkShips, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
-	gridWith6SunkShips, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
-	gridWith7SunkShips, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
-	gridWith8SunkShips, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 2, 3, Horizontal)
+	gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 3, 4, Horizontal)
+	gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 4, 5, Horizontal)
+	gridWith5Ships, _ := PlaceShip(gridWith4Ships, "Battleship", 5, 6, Horizontal)
+	gridWith6Ships, _ := PlaceShip(gridWith5Ships, "Battleship", 6, 4, Horizontal)
+	gridWith7Ships, _ := PlaceShip(gridWith6Ships, "Battleship", 5, 1, Horizontal)
+	gridWith8Ships, _ := PlaceShip(gridWith7Ships, "Battleship", 1, 3, Horizontal)
+	gridWith9Ships, _ := PlaceShip(gridWith8Ships, "Battleship", 2, 4, Horizontal)
+
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith9Ships, 1, 2)
+	gridWith2SunkShips, _, _, _ := shootOpponent(gridWith1SunkShip, 2, 3)
+	gridWith3SunkShips, _, _, _ := shootOpponent(gridWith2SunkShips, 3, 4)
+	gridWith4SunkShips, _, _, _ := shootOpponent(gridWith3SunkShips, 4, 5)
+	gridWith5SunkShips, _, _, _ := shootOpponent(gridWith4SunkShips, 5, 6)
+	gridWith6SunkShips, _, _, _ := shootOpponent(gridWith5SunkShips, 6, 4)
+	gridWith7SunkShips, _, _, _ := shootOpponent(gridWith6SunkShips, 5, 1)
+	gridWith8SunkShips, _, _, _ := shootOpponent(gridWith7SunkShips, 1, 3)
 
 	//Act
-	_, _, got, _ := CurrentPlayerTakeShot(player, gridWith8SunkShips, -1, 4)
+	result, _ := gameInBattle(player, gridWith8SunkShips).CurrentPlayerTakeShot(player, -1, 4)
+	got := result.GameOver
 
 	//Assert
 	want := false
@@ -622,10 +821,10 @@ func TestReportInvalidShotCoordinatesErrorToUserOnTurn(t *testing.T) {
 	}
 
 	shotCoordinates := []coordinates{
-		{row: 7, col: 6, errorText: "invalid row value: row = 7, want between 0 & 6 "},
-		{row: -1, col: 0, errorText: "invalid row value: row = -1, want between 0 & 6 "},
-		{row: 0, col: -1, errorText: "invalid column value: column = -1, want between 0 & 6 "},
-		{row: 6, col: 7, errorText: "invalid column value: column = 7, want between 0 & 6 "},
+		{row: 7, col: 6, errorText: "G8 is off the grid, want A1 to G7"},
+		{row: -1, col: 0, errorText: "row -1 column 0 is off the grid, want A1 to G7"},
+		{row: 0, col: -1, errorText: "row 0 column -1 is off the grid, want A1 to G7"},
+		{row: 6, col: 7, errorText: "H7 is off the grid, want A1 to G7"},
 	}
 
 	for _, coordinates := range shotCoordinates {
@@ -633,7 +832,7 @@ func TestReportInvalidShotCoordinatesErrorToUserOnTurn(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, _, _, got := CurrentPlayerTakeShot(1, grid, coordinates.row, coordinates.col)
+		_, got := gameInBattle(1, grid).CurrentPlayerTakeShot(1, coordinates.row, coordinates.col)
 
 		//assert
 		want := errors.New(coordinates.errorText)
@@ -661,7 +860,7 @@ func TestValidMissedShotReturnsNoErrorOnTurn(t *testing.T) {
 		grid := CreateGrid()
 
 		//act
-		_, _, _, got := CurrentPlayerTakeShot(1, grid, coordinates.row, coordinates.col)
+		_, got := gameInBattle(1, grid).CurrentPlayerTakeShot(1, coordinates.row, coordinates.col)
 
 		//assert
 		if got != nil {
@@ -686,13 +885,13 @@ func TestValidHitShotReturnsNoErrorOnTurn(t *testing.T) {
 	for _, coordinates := range shotCoordinates {
 		//arrange
 		grid := CreateGrid()
-		gridWith1Ship, _ := PlaceShip(grid, 0, 6)
-		gridWith2Ships, _ := PlaceShip(gridWith1Ship, 0, 0)
-		gridWith3Ships, _ := PlaceShip(gridWith2Ships, 6, 6)
-		gridWith4Ships, _ := PlaceShip(gridWith3Ships, 6, 0)
+		gridWith1Ship, _ := PlaceShip(grid, "Battleship", 0, 6, Horizontal)
+		gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 0, 0, Horizontal)
+		gridWith3Ships, _ := PlaceShip(gridWith2Ships, "Battleship", 6, 6, Horizontal)
+		gridWith4Ships, _ := PlaceShip(gridWith3Ships, "Battleship", 6, 0, Horizontal)
 
 		//act
-		_, _, _, got := CurrentPlayerTakeShot(1, gridWith4Ships, coordinates.row, coordinates.col)
+		_, got := gameInBattle(1, gridWith4Ships).CurrentPlayerTakeShot(1, coordinates.row, coordinates.col)
 
 		//assert
 		if got != nil {
@@ -700,3 +899,465 @@ func TestValidHitShotReturnsNoErrorOnTurn(t *testing.T) {
 		}
 	}
 }
+
+// gameInBattle skips setup so turn tests can start from any grid and player.
+func gameInBattle(player int, opponentGrid Board) *Game {
+	game, _ := NewGame(opponentGrid.Rules())
+	game.boards[changePlayer(player)-1] = opponentGrid
+	game.currentPlayer = player
+	game.phase = PhaseBattle
+	return game
+}
+
+func TestNewGameStartsInSetupWithPlayer1(t *testing.T) {
+	//Act
+	game, err := NewGame(ClassicRules)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if game.Phase() != PhaseSetup {
+		t.Errorf("got %v, want %v", game.Phase(), PhaseSetup)
+	}
+	if game.CurrentPlayer() != 1 {
+		t.Errorf("got player %d, want player 1", game.CurrentPlayer())
+	}
+	board, _ := game.Board(2)
+	if board.Width() != 10 || board.Height() != 10 {
+		t.Errorf("got %dx%d board, want 10x10", board.Width(), board.Height())
+	}
+}
+
+func TestNewGameRejectsInvalidRules(t *testing.T) {
+	//Act
+	_, got := NewGame(Rules{Width: -1, Height: 7, Fleet: DefaultFleet})
+
+	//Assert
+	want := errors.New("invalid board size: -1x7, want at least 1x1")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGamePlacesShipsOnEachPlayersOwnBoard(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(DefaultRules)
+
+	//Act
+	game.PlaceShip(1, "Battleship", 1, 1, Horizontal)
+	game.PlaceShip(2, "Battleship", 5, 5, Horizontal)
+
+	//Assert
+	board1, _ := game.Board(1)
+	board2, _ := game.Board(2)
+	if board1.cells[1][1] != CellShip || board1.cells[5][5] != CellEmpty {
+		t.Errorf("player 1 board has the wrong ships: %v", board1.cells)
+	}
+	if board2.cells[5][5] != CellShip || board2.cells[1][1] != CellEmpty {
+		t.Errorf("player 2 board has the wrong ships: %v", board2.cells)
+	}
+}
+
+func TestGameRejectsPlacementDuringBattle(t *testing.T) {
+	//Arrange
+	game := gameInBattle(1, CreateGrid())
+
+	//Act
+	got := game.PlaceShip(1, "Battleship", 1, 1, Horizontal)
+
+	//Assert
+	if !errors.Is(got, ErrWrongPhase) {
+		t.Errorf("got %v, want %v", got, ErrWrongPhase)
+	}
+}
+
+func TestGameRejectsShotDuringSetup(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(DefaultRules)
+
+	//Act
+	_, got := game.CurrentPlayerTakeShot(1, 1, 1)
+
+	//Assert
+	if !errors.Is(got, ErrWrongPhase) {
+		t.Errorf("got %v, want %v", got, ErrWrongPhase)
+	}
+}
+
+func TestGameRejectsShotOutOfTurn(t *testing.T) {
+	//Arrange
+	game := gameInBattle(1, CreateGrid())
+
+	//Act
+	result, got := game.CurrentPlayerTakeShot(2, 1, 1)
+	player := result.NextPlayer
+
+	//Assert
+	if !errors.Is(got, ErrNotYourTurn) {
+		t.Errorf("got %v, want %v", got, ErrNotYourTurn)
+	}
+	if player != 1 {
+		t.Errorf("got player %d, want player 1", player)
+	}
+}
+
+func TestGameRejectsInvalidPlayer(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(DefaultRules)
+
+	//Act
+	got := game.PlaceShip(3, "Battleship", 1, 1, Horizontal)
+
+	//Assert
+	want := errors.New("invalid player: player = 3, want 1 or 2")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGameShotsLandOnOpponentsBoard(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Battleship", Length: 1, Count: 2}}})
+	game.PlaceShip(1, "Battleship", 2, 2, Horizontal)
+	game.PlaceShip(1, "Battleship", 0, 0, Horizontal)
+	game.PlaceShip(2, "Battleship", 4, 4, Horizontal)
+	game.PlaceShip(2, "Battleship", 6, 6, Horizontal)
+	game.StartBattle()
+
+	//Act
+	player1Shot, _ := game.CurrentPlayerTakeShot(1, 4, 4)
+	player2Shot, _ := game.CurrentPlayerTakeShot(2, 4, 4)
+
+	//Assert
+	if player1Shot.Outcome != OutcomeSunk || player2Shot.Outcome != OutcomeMiss {
+		t.Errorf("got %v and %v, want %v and %v", player1Shot.Outcome, player2Shot.Outcome, OutcomeSunk, OutcomeMiss)
+	}
+	board1, _ := game.Board(1)
+	if board1.cells[4][4] != CellMiss || board1.cells[2][2] != CellShip {
+		t.Errorf("player 1 board has the wrong shots: %v", board1.cells)
+	}
+}
+
+func TestGameFinishesWhenLastShipIsHit(t *testing.T) {
+	//Arrange
+	grid, _ := CreateBoard(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Battleship", Length: 1, Count: 1}}})
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	game := gameInBattle(2, gridWith1Ship)
+
+	//Act
+	game.CurrentPlayerTakeShot(2, 1, 2)
+	_, got := game.CurrentPlayerTakeShot(1, 3, 3)
+
+	//Assert
+	if game.Phase() != PhaseFinished || game.Winner() != 2 {
+		t.Errorf("got %v phase with winner %d, want %v phase with winner 2", game.Phase(), game.Winner(), PhaseFinished)
+	}
+	if !errors.Is(got, ErrGameOver) {
+		t.Errorf("got %v, want %v", got, ErrGameOver)
+	}
+}
+
+// classicGameInBattle gives both players classicLayout under rules and starts
+// the battle.
+func classicGameInBattle(rules Rules) *Game {
+	game, _ := NewGame(rules)
+	game.PlaceFleet(1, classicLayout)
+	game.PlaceFleet(2, classicLayout)
+	game.StartBattle()
+	return game
+}
+
+func salvoRules() Rules {
+	rules := ClassicRules
+	rules.Mode = ModeSalvo
+	return rules
+}
+
+func TestSalvoResolvesEveryShotAndPassesTurn(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(salvoRules())
+	volley := []Coord{{Row: 8, Col: 0}, {Row: 8, Col: 1}, {Row: 0, Col: 0}, {Row: 9, Col: 9}, {Row: 5, Col: 5}}
+
+	//Act
+	got, err := game.CurrentPlayerTakeSalvo(1, volley)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	want := []ShotResult{
+		{Row: 8, Col: 0, Outcome: OutcomeHit, ShipsRemaining: 5, NextPlayer: 2},
+		{Row: 8, Col: 1, Outcome: OutcomeSunk, SunkShip: "Destroyer", ShipsRemaining: 4, NextPlayer: 2},
+		{Row: 0, Col: 0, Outcome: OutcomeHit, ShipsRemaining: 4, NextPlayer: 2},
+		{Row: 9, Col: 9, Outcome: OutcomeMiss, ShipsRemaining: 4, NextPlayer: 2},
+		{Row: 5, Col: 5, Outcome: OutcomeMiss, ShipsRemaining: 4, NextPlayer: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if game.CurrentPlayer() != 2 {
+		t.Errorf("got player %d, want player 2", game.CurrentPlayer())
+	}
+}
+
+func TestSalvoSizeShrinksWithSunkShips(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(salvoRules())
+	game.CurrentPlayerTakeSalvo(1, []Coord{{9, 0}, {9, 1}, {9, 2}, {9, 3}, {9, 4}})
+	game.CurrentPlayerTakeSalvo(2, []Coord{{8, 0}, {8, 1}, {9, 0}, {9, 1}, {9, 2}})
+
+	//Act
+	_, got := game.CurrentPlayerTakeSalvo(1, []Coord{{9, 5}, {9, 6}, {9, 7}, {9, 8}, {9, 9}})
+
+	//Assert
+	want := errors.New("wrong number of shots in salvo: salvo has 5 shots, want 4")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if !errors.Is(got, ErrSalvoSize) {
+		t.Errorf("got %v, want %v", got, ErrSalvoSize)
+	}
+}
+
+func TestInvalidSalvoChangesNothing(t *testing.T) {
+	type salvo struct {
+		name   string
+		volley []Coord
+		want   error
+	}
+	salvos := []salvo{
+		{name: "off grid", volley: []Coord{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {10, 0}}, want: ErrOutOfBounds},
+		{name: "same square twice", volley: []Coord{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {0, 0}}, want: ErrAlreadyShot},
+	}
+
+	for _, salvo := range salvos {
+		//arrange
+		game := classicGameInBattle(salvoRules())
+		boardBefore, _ := game.Board(2)
+
+		//act
+		results, got := game.CurrentPlayerTakeSalvo(1, salvo.volley)
+
+		//assert
+		if !errors.Is(got, salvo.want) {
+			t.Errorf("%s: got %v, want %v", salvo.name, got, salvo.want)
+		}
+		boardAfter, _ := game.Board(2)
+		if results != nil || !reflect.DeepEqual(boardAfter, boardBefore) || game.CurrentPlayer() != 1 {
+			t.Errorf("%s: invalid salvo changed the game", salvo.name)
+		}
+	}
+}
+
+func TestSalvoModeRejectsSingleShots(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(salvoRules())
+
+	//Act
+	_, got := game.CurrentPlayerTakeShot(1, 0, 0)
+
+	//Assert
+	if !errors.Is(got, ErrWrongMode) {
+		t.Errorf("got %v, want %v", got, ErrWrongMode)
+	}
+}
+
+func TestClassicModeRejectsSalvo(t *testing.T) {
+	//Arrange
+	game := gameInBattle(1, CreateGrid())
+
+	//Act
+	_, got := game.CurrentPlayerTakeSalvo(1, []Coord{{0, 0}})
+
+	//Assert
+	if !errors.Is(got, ErrWrongMode) {
+		t.Errorf("got %v, want %v", got, ErrWrongMode)
+	}
+}
+
+func TestSalvoThatSinksLastShipWinsGame(t *testing.T) {
+	//Arrange
+	rules := Rules{Width: 5, Height: 5, Fleet: FleetSpec{{Name: "Destroyer", Length: 2, Count: 2}}, Mode: ModeSalvo}
+	game, _ := NewGame(rules)
+	game.PlaceShip(1, "Destroyer", 0, 0, Horizontal)
+	game.PlaceShip(1, "Destroyer", 2, 0, Horizontal)
+	game.PlaceShip(2, "Destroyer", 0, 0, Horizontal)
+	game.PlaceShip(2, "Destroyer", 2, 0, Horizontal)
+	game.StartBattle()
+	game.CurrentPlayerTakeSalvo(1, []Coord{{0, 0}, {0, 1}})
+	game.CurrentPlayerTakeSalvo(2, []Coord{{4, 0}})
+
+	//Act
+	got, _ := game.CurrentPlayerTakeSalvo(1, []Coord{{2, 0}, {2, 1}})
+
+	//Assert
+	if !got[1].GameOver || game.Phase() != PhaseFinished || game.Winner() != 1 {
+		t.Errorf("got %+v in %v phase with winner %d, want player 1 to win", got[1], game.Phase(), game.Winner())
+	}
+}
+
+func TestTurnPolicies(t *testing.T) {
+	type turn struct {
+		name   string
+		policy TurnPolicy
+		row    int
+		col    int
+		want   int
+	}
+	turns := []turn{
+		{name: "alternate after hit", policy: TurnPolicyAlternate, row: 1, col: 2, want: 2},
+		{name: "alternate after miss", policy: TurnPolicyAlternate, row: 5, col: 5, want: 2},
+		{name: "shoot again after hit", policy: TurnPolicyShootAgainOnHit, row: 1, col: 2, want: 1},
+		{name: "shoot again after sinking", policy: TurnPolicyShootAgainOnHit, row: 3, col: 3, want: 1},
+		{name: "shoot again after miss", policy: TurnPolicyShootAgainOnHit, row: 5, col: 5, want: 2},
+	}
+
+	for _, turn := range turns {
+		//arrange
+		rules := ClassicRules
+		rules.TurnPolicy = turn.policy
+		grid, _ := CreateBoard(rules)
+		gridWith1Ship, _ := PlaceShip(grid, "Cruiser", 1, 2, Horizontal)
+		gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Destroyer", 3, 2, Horizontal)
+		gridWithHit, _, _, _ := shootOpponent(gridWith2Ships, 3, 2)
+		game := gameInBattle(1, gridWithHit)
+
+		//act
+		result, _ := game.CurrentPlayerTakeShot(1, turn.row, turn.col)
+
+		//assert
+		if result.NextPlayer != turn.want || game.CurrentPlayer() != turn.want {
+			t.Errorf("%s: got player %d, want player %d", turn.name, result.NextPlayer, turn.want)
+		}
+	}
+}
+
+func TestShootAgainOnHitDoesNotApplyToInvalidShots(t *testing.T) {
+	//Arrange
+	rules := DefaultRules
+	rules.TurnPolicy = TurnPolicyShootAgainOnHit
+	grid, _ := CreateBoard(rules)
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith2Ships, _ := PlaceShip(gridWith1Ship, "Battleship", 4, 4, Horizontal)
+	game := gameInBattle(2, gridWith2Ships)
+	game.CurrentPlayerTakeShot(2, 1, 2)
+
+	//Act
+	result, err := game.CurrentPlayerTakeShot(2, 1, 2)
+
+	//Assert
+	if !errors.Is(err, ErrAlreadyShot) || result.NextPlayer != 2 {
+		t.Errorf("got %v with player %d next, want %v with player 2 next", err, result.NextPlayer, ErrAlreadyShot)
+	}
+}
+
+func TestShootAgainOnHitDoesNotApplyToSalvos(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(salvoRules())
+	game.rules.TurnPolicy = TurnPolicyShootAgainOnHit
+
+	//Act
+	game.CurrentPlayerTakeSalvo(1, []Coord{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}})
+
+	//Assert
+	if game.CurrentPlayer() != 2 {
+		t.Errorf("got player %d, want player 2", game.CurrentPlayer())
+	}
+}
+
+func TestCannotStartBattleUntilBothFleetsAreComplete(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Battleship", Length: 1, Count: 2}}})
+	game.PlaceShip(1, "Battleship", 0, 0, Horizontal)
+	game.PlaceShip(1, "Battleship", 1, 1, Horizontal)
+	game.PlaceShip(2, "Battleship", 0, 0, Horizontal)
+
+	//Act
+	got := game.StartBattle()
+
+	//Assert
+	want := errors.New("player 2 is not ready: too few ships of class Battleship: placed 1, fleet has 2")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if !errors.Is(got, ErrFleetIncomplete) {
+		t.Errorf("got %v, want %v", got, ErrFleetIncomplete)
+	}
+	if game.Phase() != PhaseSetup {
+		t.Errorf("got %v phase, want %v", game.Phase(), PhaseSetup)
+	}
+}
+
+func TestCanStartBattleWithBothFleetsComplete(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Battleship", Length: 1, Count: 1}}})
+	game.PlaceShip(1, "Battleship", 0, 0, Horizontal)
+	game.PlaceShip(2, "Battleship", 0, 0, Horizontal)
+
+	//Act
+	got := game.StartBattle()
+
+	//Assert
+	if got != nil || game.Phase() != PhaseBattle {
+		t.Errorf("got %v in %v phase, want no error in %v phase", got, game.Phase(), PhaseBattle)
+	}
+}
+
+func TestCheckReadyReportsEachPlayer(t *testing.T) {
+	//Arrange
+	game, _ := NewGame(Rules{Width: 7, Height: 7, Fleet: FleetSpec{{Name: "Battleship", Length: 1, Count: 1}}})
+	game.PlaceShip(1, "Battleship", 0, 0, Horizontal)
+
+	//Act
+	player1 := game.CheckReady(1)
+	player2 := game.CheckReady(2)
+
+	//Assert
+	if player1 != nil {
+		t.Errorf("player 1: got %v, want no error", player1)
+	}
+	if !errors.Is(player2, ErrFleetIncomplete) {
+		t.Errorf("player 2: got %v, want %v", player2, ErrFleetIncomplete)
+	}
+}
+
+func TestCannotCreateGameWithEmptyFleet(t *testing.T) {
+	//Act
+	_, got := NewGame(Rules{Width: 7, Height: 7})
+
+	//Assert
+	if !errors.Is(got, ErrInvalidFleet) {
+		t.Errorf("got %v, want %v", got, ErrInvalidFleet)
+	}
+}
+
+func TestHasPlayerNotWonAgainstEmptyGrid(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+
+	//Act
+	got := HasPlayerWon(grid)
+
+	//Assert
+	want := false
+	if got != want {
+		t.Errorf("wanted %v got %v", want, got)
+	}
+}
+
+func TestHasPlayerNotWonAgainstIncompleteFleet(t *testing.T) {
+	//Arrange
+	grid := CreateGrid()
+	gridWith1Ship, _ := PlaceShip(grid, "Battleship", 1, 2, Horizontal)
+	gridWith1SunkShip, _, _, _ := shootOpponent(gridWith1Ship, 1, 2)
+
+	//Act
+	got := HasPlayerWon(gridWith1SunkShip)
+
+	//Assert
+	want := false
+	if got != want {
+		t.Errorf("wanted %v got %v", want, got)
+	}
+}
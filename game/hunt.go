@@ -0,0 +1,102 @@
+package game
+
+import "math/rand"
+
+type HuntTargetStrategy struct {
+	rng *rand.Rand
+}
+
+// NewHuntTargetStrategy hunts on a checkerboard spaced for the smallest ship
+// still afloat. Once it has a hit that is not part of a sunk ship it targets
+// that ship, following the line of hits once there are two in a row.
+func NewHuntTargetStrategy(rng *rand.Rand) *HuntTargetStrategy {
+	return &HuntTargetStrategy{rng: rng}
+}
+
+func (strategy *HuntTargetStrategy) NextShot(tracking View) Coord {
+	candidates := targetSquares(tracking)
+	if len(candidates) == 0 {
+		candidates = huntSquares(tracking)
+	}
+	if len(candidates) == 0 {
+		candidates = untargetedSquares(tracking)
+	}
+	if len(candidates) == 0 {
+		return Coord{}
+	}
+	return candidates[strategy.rng.Intn(len(candidates))]
+}
+
+var axes = [2][2]int{{0, 1}, {1, 0}}
+
+// targetSquares returns the untargeted squares at either end of a line of
+// open hits, or failing that every untargeted square next to an open hit.
+// Squares the rules keep clear of sunk ships are left out.
+func targetSquares(tracking View) []Coord {
+	var alongLine, neighbours []Coord
+	for row := 0; row < tracking.height; row++ {
+		for col := 0; col < tracking.width; col++ {
+			if tracking.cells[row][col] != CellHit {
+				continue
+			}
+			for _, axis := range axes {
+				before := Coord{Row: row - axis[0], Col: col - axis[1]}
+				after := Coord{Row: row + axis[0], Col: col + axis[1]}
+				inLine := tracking.At(before) == CellHit || tracking.At(after) == CellHit
+				for _, next := range []Coord{before, after} {
+					if !tracking.onGrid(next) || tracking.At(next) != CellEmpty || tracking.nextToSunkShip(next) {
+						continue
+					}
+					if inLine {
+						alongLine = append(alongLine, next)
+					} else {
+						neighbours = append(neighbours, next)
+					}
+				}
+			}
+		}
+	}
+	if len(alongLine) > 0 {
+		return alongLine
+	}
+	return neighbours
+}
+
+// huntSquares skips squares that no remaining ship needs checked: every ship
+// of length n covers exactly one square in each n-spaced diagonal stripe, and
+// under NoTouching nothing lies next to a sunk ship.
+func huntSquares(tracking View) []Coord {
+	spacing := 0
+	for _, shipClass := range remainingFleet(tracking) {
+		if spacing == 0 || shipClass.Length < spacing {
+			spacing = shipClass.Length
+		}
+	}
+	if spacing < 1 {
+		spacing = 1
+	}
+
+	var squares []Coord
+	for _, square := range untargetedSquares(tracking) {
+		if (square.Row+square.Col)%spacing == 0 && !tracking.nextToSunkShip(square) {
+			squares = append(squares, square)
+		}
+	}
+	return squares
+}
+
+// remainingFleet is the tracking view's fleet less the ships already sunk.
+func remainingFleet(tracking View) FleetSpec {
+	sunk := map[string]int{}
+	for _, ship := range tracking.sunkShips {
+		sunk[ship.Class]++
+	}
+	var remaining FleetSpec
+	for _, shipClass := range tracking.fleet {
+		shipClass.Count -= sunk[shipClass.Name]
+		if shipClass.Count > 0 {
+			remaining = append(remaining, shipClass)
+		}
+	}
+	return remaining
+}
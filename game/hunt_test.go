@@ -0,0 +1,161 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func trackingViewOf(t *testing.T, text string) View {
+	t.Helper()
+	board, parseErr := ParseBoard(ClassicRules, text)
+	if parseErr != nil {
+		t.Fatalf("got %v, want no error", parseErr)
+	}
+	return board.TrackingView()
+}
+
+func TestHuntTargetProbesAroundASingleHit(t *testing.T) {
+	//Arrange
+	tracking := trackingViewOf(t, "   A B C D E F G H I J\n"+
+		" 1 . . . . . . . . . .\n"+
+		" 2 . . . . . . . . . .\n"+
+		" 3 . . . . . . . . . .\n"+
+		" 4 . . . . . . . . . .\n"+
+		" 5 . . . . X S S . . .\n"+
+		" 6 . . . . . . . . . .\n"+
+		" 7 . . . . . . . . . .\n"+
+		" 8 . . . . . . . . . .\n"+
+		" 9 . . . . . . . . . .\n"+
+		"10 . . . . . . . . . .\n"+
+		"Cruiser E5 across\n")
+	neighbours := map[Coord]bool{{Row: 3, Col: 4}: true, {Row: 5, Col: 4}: true, {Row: 4, Col: 3}: true, {Row: 4, Col: 5}: true}
+
+	for seed := int64(0); seed < 20; seed++ {
+		//act
+		got := NewHuntTargetStrategy(rand.New(rand.NewSource(seed))).NextShot(tracking)
+
+		//assert
+		if !neighbours[got] {
+			t.Errorf("seed %d: got %s, want a square next to E5", seed, got)
+		}
+	}
+}
+
+func TestHuntTargetFollowsALineOfHits(t *testing.T) {
+	//Arrange
+	tracking := trackingViewOf(t, "   A B C D E F G H I J\n"+
+		" 1 . . . . . . . . . .\n"+
+		" 2 . . . . . . . . . .\n"+
+		" 3 . . . . . . . . . .\n"+
+		" 4 . . . . . . . . . .\n"+
+		" 5 . . . o X X S S . .\n"+
+		" 6 . . . . . . . . . .\n"+
+		" 7 . . . . . . . . . .\n"+
+		" 8 . . . . . . . . . .\n"+
+		" 9 . . . . . . . . . .\n"+
+		"10 . . . . . . . . . .\n"+
+		"Battleship E5 across\n")
+
+	for seed := int64(0); seed < 20; seed++ {
+		//act
+		got := NewHuntTargetStrategy(rand.New(rand.NewSource(seed))).NextShot(tracking)
+
+		//assert
+		want := Coord{Row: 4, Col: 6}
+		if got != want {
+			t.Errorf("seed %d: got %s, want %s", seed, got, want)
+		}
+	}
+}
+
+func TestHuntTargetHuntsOnParityAfterASinking(t *testing.T) {
+	//Arrange
+	tracking := trackingViewOf(t, "   A B C D E F G H I J\n"+
+		" 1 . . . . . . . . . .\n"+
+		" 2 . . . . . . . . . .\n"+
+		" 3 . . . . . . . . . .\n"+
+		" 4 . . . . . . . . . .\n"+
+		" 5 . . . . X X . . . .\n"+
+		" 6 . . . . . . . . . .\n"+
+		" 7 . . . . . . . . . .\n"+
+		" 8 . . . . . . . . . .\n"+
+		" 9 . . . . . . . . . .\n"+
+		"10 . . . . . . . . . .\n"+
+		"Destroyer E5 across\n")
+
+	for seed := int64(0); seed < 20; seed++ {
+		//act
+		got := NewHuntTargetStrategy(rand.New(rand.NewSource(seed))).NextShot(tracking)
+
+		//assert
+		if tracking.At(got) != CellEmpty || (got.Row+got.Col)%3 != 0 {
+			t.Errorf("seed %d: got %s, want an untargeted square on the 3-spaced stripes", seed, got)
+		}
+	}
+}
+
+func TestHuntTargetSinksAFleetFasterThanRandom(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceFleet(board, classicLayout)
+
+	//Act
+	huntTotal, randomTotal := 0, 0
+	for seed := int64(0); seed < 20; seed++ {
+		huntTotal += shotsToSink(t, board, NewHuntTargetStrategy(rand.New(rand.NewSource(seed))))
+		randomTotal += shotsToSink(t, board, NewRandomStrategy(rand.New(rand.NewSource(seed))))
+	}
+
+	//Assert
+	if huntTotal >= randomTotal {
+		t.Errorf("got %d shots over 20 games, want fewer than random's %d", huntTotal, randomTotal)
+	}
+}
+
+func shotsToSink(t *testing.T, board Board, strategy Strategy) int {
+	t.Helper()
+	shots := 0
+	for !HasPlayerWon(board) {
+		shot := strategy.NextShot(board.TrackingView())
+		var shotErr error
+		board, shotErr, _, _ = shootOpponent(board, shot.Row, shot.Col)
+		if shotErr != nil {
+			t.Fatalf("shot %d at %s: %v", shots, shot, shotErr)
+		}
+		shots++
+	}
+	return shots
+}
+
+func TestHuntTargetLeavesOutSquaresTouchingASunkShip(t *testing.T) {
+	type position struct {
+		name    string
+		openHit *Coord
+	}
+	positions := []position{
+		{name: "hunting"},
+		{name: "targeting", openHit: &Coord{Row: 4, Col: 2}},
+	}
+
+	for _, position := range positions {
+		//arrange
+		board, _ := CreateBoard(MorskoiBoiRules)
+		board, _ = PlaceShip(board, "Boat", 4, 4, Horizontal)
+		board, _, _, _ = shootOpponent(board, 4, 4)
+		if position.openHit != nil {
+			board, _ = PlaceShip(board, "Destroyer", position.openHit.Row-1, position.openHit.Col, Vertical)
+			board, _, _, _ = shootOpponent(board, position.openHit.Row, position.openHit.Col)
+		}
+		tracking := board.TrackingView()
+
+		for seed := int64(0); seed < 50; seed++ {
+			//act
+			got := NewHuntTargetStrategy(rand.New(rand.NewSource(seed))).NextShot(tracking)
+
+			//assert
+			if got.Row >= 3 && got.Row <= 5 && got.Col >= 3 && got.Col <= 5 {
+				t.Errorf("%s, seed %d: got %s, want no square next to the sunk Boat at E5", position.name, seed, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const (
+	smartPlacementCandidates = 50
+	touchingShipsPenalty     = 20
+	orientationPenalty       = 10
+)
+
+// PlaceFleetSmartly places fleet like PlaceFleetRandomly, but draws a number
+// of random layouts and keeps the one hardest to find: ships along the edges,
+// apart from each other and lying both ways.
+func PlaceFleetSmartly(board Board, fleet FleetSpec, rng *rand.Rand) (Board, error) {
+	// Nothing has been shot yet, so this is how likely a shooter thinks each
+	// square is before the first shot.
+	density := placementDensity(board.TrackingView())
+
+	var best Board
+	var bestScore int
+	var placeErr error
+	found := false
+	for candidate := 0; candidate < smartPlacementCandidates; candidate++ {
+		var placed Board
+		placed, placeErr = PlaceFleetRandomly(board, fleet, rng)
+		if placeErr != nil {
+			continue
+		}
+		score := layoutScore(placed, density)
+		if !found || score > bestScore {
+			best, bestScore, found = placed, score, true
+		}
+	}
+	if !found {
+		return board, placeErr
+	}
+	return best, nil
+}
+
+// layoutScore rewards the things shooting strategies are slowest to find.
+// Ships on squares where an unseen fleet is most likely to be are found
+// first, and hunting around one ship tends to turn up any ship touching it.
+func layoutScore(board Board, density [][]int) int {
+	score := 0
+	horizontal, vertical := 0, 0
+	for i, ship := range board.ships {
+		for _, square := range ship.squares() {
+			score -= density[square[0]][square[1]]
+		}
+		for _, other := range board.ships[i+1:] {
+			if shipsTouch(ship, other) {
+				score -= touchingShipsPenalty
+			}
+		}
+		if ship.Length > 1 {
+			if ship.Orientation == Horizontal {
+				horizontal++
+			} else {
+				vertical++
+			}
+		}
+	}
+	if horizontal > vertical {
+		score -= orientationPenalty * (horizontal - vertical)
+	} else {
+		score -= orientationPenalty * (vertical - horizontal)
+	}
+	return score
+}
+
+func shipsTouch(ship Ship, other Ship) bool {
+	for _, square := range ship.squares() {
+		for _, otherSquare := range other.squares() {
+			rowGap, colGap := square[0]-otherSquare[0], square[1]-otherSquare[1]
+			if rowGap >= -1 && rowGap <= 1 && colGap >= -1 && colGap <= 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AverageShotsToSink fires strategy at board until the whole fleet is sunk,
+// games times over, and returns the mean number of shots the fleet survived.
+// A game is cut off once strategy has fired as many shots as there are
+// squares, so a strategy that keeps repeating itself cannot run forever.
+func AverageShotsToSink(board Board, strategy Strategy, games int) (float64, error) {
+	fleetErr := CheckFleet(board)
+	if fleetErr != nil {
+		return 0, fleetErr
+	}
+	if games < 1 {
+		return 0, fmt.Errorf("%w: %d, want at least 1", ErrInvalidGameCount, games)
+	}
+
+	total := 0
+	for round := 0; round < games; round++ {
+		shotAt := board
+		shots := 0
+		for shots < board.Width()*board.Height() && !HasPlayerWon(shotAt) {
+			shot := strategy.NextShot(shotAt.TrackingView())
+			shotAt, _, _, _ = shootOpponent(shotAt, shot.Row, shot.Col)
+			shots++
+		}
+		total += shots
+	}
+	return float64(total) / float64(games), nil
+}
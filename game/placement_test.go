@@ -0,0 +1,142 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestPlaceFleetSmartlyPlacesWholeFleet(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(MorskoiBoiRules)
+
+	//Act
+	got, err := PlaceFleetSmartly(board, MorskoiBoiFleet, rand.New(rand.NewSource(1)))
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if fleetErr := CheckFleet(got); fleetErr != nil {
+		t.Errorf("got %v, want a complete fleet", fleetErr)
+	}
+}
+
+func TestPlaceFleetSmartlyIsReproducibleWithASeed(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	first, _ := PlaceFleetSmartly(board, ClassicFleet, rand.New(rand.NewSource(4)))
+	second, _ := PlaceFleetSmartly(board, ClassicFleet, rand.New(rand.NewSource(4)))
+
+	//Assert
+	if !reflect.DeepEqual(first.Ships(), second.Ships()) {
+		t.Errorf("got %v, then %v with the same seed", first.Ships(), second.Ships())
+	}
+}
+
+func TestPlaceFleetSmartlyRejectsFleetThatDoesNotFit(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(Rules{Width: 3, Height: 3, Fleet: DefaultFleet})
+	fleet := FleetSpec{{Name: "Battleship", Length: 1, Count: 10}}
+
+	//Act
+	_, got := PlaceFleetSmartly(board, fleet, rand.New(rand.NewSource(1)))
+
+	//Assert
+	if got == nil {
+		t.Errorf("got no error, want an error")
+	}
+}
+
+func TestPlaceFleetSmartlySkipsFailedDraws(t *testing.T) {
+	//Arrange
+	// Nine boats that may not touch only just fit on 5x5, so plenty of random
+	// draws fail, including the very first one for seed 97.
+	rules := Rules{Width: 5, Height: 5, NoTouching: true, Fleet: FleetSpec{{Name: "Boat", Length: 1, Count: 9}}}
+
+	for seed := int64(95); seed <= 97; seed++ {
+		board, _ := CreateBoard(rules)
+
+		//act
+		got, err := PlaceFleetSmartly(board, rules.Fleet, rand.New(rand.NewSource(seed)))
+
+		//assert
+		if err != nil {
+			t.Fatalf("seed %d: got %v, want no error", seed, err)
+		}
+		if fleetErr := CheckFleet(got); fleetErr != nil {
+			t.Errorf("seed %d: got %v, want a complete fleet", seed, fleetErr)
+		}
+	}
+}
+
+func TestSmartLayoutsSurviveDensityShootingLonger(t *testing.T) {
+	//Arrange
+	smartTotal, randomTotal := 0.0, 0.0
+
+	//Act
+	for seed := int64(0); seed < 20; seed++ {
+		board, _ := CreateBoard(ClassicRules)
+		randomLayout, _ := PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(seed)))
+		smartLayout, _ := PlaceFleetSmartly(board, ClassicFleet, rand.New(rand.NewSource(seed)))
+		randomShots, _ := AverageShotsToSink(randomLayout, NewProbabilityStrategy(rand.New(rand.NewSource(seed))), 3)
+		smartShots, _ := AverageShotsToSink(smartLayout, NewProbabilityStrategy(rand.New(rand.NewSource(seed))), 3)
+		randomTotal += randomShots
+		smartTotal += smartShots
+	}
+
+	//Assert
+	if smartTotal <= randomTotal {
+		t.Errorf("smart layouts survived %.1f shots in all, want more than random layouts' %.1f", smartTotal, randomTotal)
+	}
+}
+
+type fixedStrategy Coord
+
+func (strategy fixedStrategy) NextShot(tracking View) Coord {
+	return Coord(strategy)
+}
+
+func TestAverageShotsToSinkStopsARepeatingStrategy(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceFleet(board, classicLayout)
+
+	//Act
+	got, err := AverageShotsToSink(board, fixedStrategy{Row: 9, Col: 9}, 2)
+
+	//Assert
+	if err != nil || got != 100 {
+		t.Errorf("got %v %v, want 100 shots", got, err)
+	}
+}
+
+func TestAverageShotsToSinkNeedsAtLeastOneGame(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceFleet(board, classicLayout)
+
+	//Act
+	_, got := AverageShotsToSink(board, NewRandomStrategy(rand.New(rand.NewSource(1))), 0)
+
+	//Assert
+	if !errors.Is(got, ErrInvalidGameCount) {
+		t.Errorf("got %v, want %v", got, ErrInvalidGameCount)
+	}
+}
+
+func TestAverageShotsToSinkNeedsACompleteFleet(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	_, got := AverageShotsToSink(board, NewRandomStrategy(rand.New(rand.NewSource(1))), 1)
+
+	//Assert
+	if !errors.Is(got, ErrFleetIncomplete) {
+		t.Errorf("got %v, want %v", got, ErrFleetIncomplete)
+	}
+}
@@ -0,0 +1,94 @@
+package game
+
+import "math/rand"
+
+// A placement that covers open hits is far more likely than one that does
+// not, so each hit it covers multiplies its weight.
+const openHitWeight = 50
+
+type ProbabilityStrategy struct {
+	rng *rand.Rand
+}
+
+// NewProbabilityStrategy fires at the square covered by the most legal
+// placements of the ships still afloat, picking at random between equals.
+func NewProbabilityStrategy(rng *rand.Rand) *ProbabilityStrategy {
+	return &ProbabilityStrategy{rng: rng}
+}
+
+func (strategy *ProbabilityStrategy) NextShot(tracking View) Coord {
+	density := placementDensity(tracking)
+
+	var best []Coord
+	bestScore := 0
+	for row := 0; row < tracking.height; row++ {
+		for col := 0; col < tracking.width; col++ {
+			if tracking.cells[row][col] != CellEmpty {
+				continue
+			}
+			switch {
+			case density[row][col] > bestScore:
+				best = append(best[:0], Coord{Row: row, Col: col})
+				bestScore = density[row][col]
+			case density[row][col] == bestScore:
+				best = append(best, Coord{Row: row, Col: col})
+			}
+		}
+	}
+	if len(best) == 0 {
+		return Coord{}
+	}
+	return best[strategy.rng.Intn(len(best))]
+}
+
+// placementDensity counts, for every square, the weighted number of ways a
+// remaining ship could lie across it. A ship cannot cross a miss or a sunk
+// ship, nor touch a sunk ship under NoTouching, and open hits count towards
+// the placements that cover them.
+func placementDensity(tracking View) [][]int {
+	density := make([][]int, tracking.height)
+	for row := range density {
+		density[row] = make([]int, tracking.width)
+	}
+
+	for _, shipClass := range remainingFleet(tracking) {
+		for _, orientation := range []Orientation{Horizontal, Vertical} {
+			for row := 0; row < tracking.height; row++ {
+				for col := 0; col < tracking.width; col++ {
+					ship := Ship{Length: shipClass.Length, Row: row, Col: col, Orientation: orientation}
+					weight, fits := placementWeight(tracking, ship)
+					if !fits {
+						continue
+					}
+					for _, square := range ship.squares() {
+						density[square[0]][square[1]] += weight * shipClass.Count
+					}
+				}
+			}
+			if shipClass.Length == 1 {
+				break
+			}
+		}
+	}
+	return density
+}
+
+func placementWeight(tracking View, ship Ship) (int, bool) {
+	weight := 1
+	for _, square := range ship.squares() {
+		coord := Coord{Row: square[0], Col: square[1]}
+		if !tracking.onGrid(coord) {
+			return 0, false
+		}
+		switch tracking.cells[coord.Row][coord.Col] {
+		case CellMiss, CellSunk:
+			return 0, false
+		case CellHit:
+			weight *= openHitWeight
+		}
+		if tracking.nextToSunkShip(coord) {
+			return 0, false
+		}
+	}
+	return weight, true
+}
@@ -0,0 +1,119 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestProbabilityStrategyOpensAwayFromTheEdges(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	for seed := int64(0); seed < 20; seed++ {
+		//act
+		got := NewProbabilityStrategy(rand.New(rand.NewSource(seed))).NextShot(board.TrackingView())
+
+		//assert
+		if got.Row < 2 || got.Row > 7 || got.Col < 2 || got.Col > 7 {
+			t.Errorf("seed %d: got %s, want a square away from the edges", seed, got)
+		}
+	}
+}
+
+func TestProbabilityStrategyTargetsAroundAnOpenHit(t *testing.T) {
+	//Arrange
+	tracking := trackingViewOf(t, "   A B C D E F G H I J\n"+
+		" 1 . . . . . . . . . .\n"+
+		" 2 . . . . . . . . . .\n"+
+		" 3 . . . . . . . . . .\n"+
+		" 4 . . . . . . . . . .\n"+
+		" 5 . . . . . . . . . .\n"+
+		" 6 . . . . . . . . . .\n"+
+		" 7 . . . . . . . . . .\n"+
+		" 8 . . . . o . . . . .\n"+
+		" 9 . . . o X S S . . .\n"+
+		"10 . . . . . . . . . .\n"+
+		"Cruiser E9 across\n")
+	want := map[Coord]bool{{Row: 8, Col: 5}: true, {Row: 9, Col: 4}: true}
+
+	for seed := int64(0); seed < 20; seed++ {
+		//act
+		got := NewProbabilityStrategy(rand.New(rand.NewSource(seed))).NextShot(tracking)
+
+		//assert
+		if !want[got] {
+			t.Errorf("seed %d: got %s, want F9 or E10", seed, got)
+		}
+	}
+}
+
+func TestProbabilityStrategyIgnoresSquaresNoShipCanReach(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(Rules{Width: 3, Height: 3, Fleet: FleetSpec{{Name: "Cruiser", Length: 3, Count: 1}}})
+	for _, miss := range []Coord{{Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 2}, {Row: 2, Col: 1}} {
+		board, _, _, _ = shootOpponent(board, miss.Row, miss.Col)
+	}
+
+	//Act
+	got := placementDensity(board.TrackingView())
+
+	//Assert
+	for _, corner := range []Coord{{Row: 0, Col: 0}, {Row: 0, Col: 2}, {Row: 2, Col: 0}, {Row: 2, Col: 2}} {
+		if got[corner.Row][corner.Col] != 0 {
+			t.Errorf("%s: got density %d, want 0", corner, got[corner.Row][corner.Col])
+		}
+	}
+}
+
+func TestProbabilityStrategySinksAFleetFasterThanHuntTarget(t *testing.T) {
+	//Act
+	probabilityTotal, huntTotal := 0, 0
+	for seed := int64(0); seed < 20; seed++ {
+		board, _ := CreateBoard(ClassicRules)
+		board, _ = PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(seed)))
+		probabilityTotal += shotsToSink(t, board, NewProbabilityStrategy(rand.New(rand.NewSource(seed))))
+		huntTotal += shotsToSink(t, board, NewHuntTargetStrategy(rand.New(rand.NewSource(seed))))
+	}
+
+	//Assert
+	if probabilityTotal >= huntTotal {
+		t.Errorf("got %d shots over 20 games, want fewer than hunt and target's %d", probabilityTotal, huntTotal)
+	}
+}
+
+func BenchmarkProbabilityStrategyNextShot(b *testing.B) {
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(1)))
+	for _, shot := range []Coord{{Row: 4, Col: 4}, {Row: 2, Col: 7}, {Row: 8, Col: 1}, {Row: 0, Col: 0}} {
+		board, _, _, _ = shootOpponent(board, shot.Row, shot.Col)
+	}
+	tracking := board.TrackingView()
+	strategy := NewProbabilityStrategy(rand.New(rand.NewSource(1)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		strategy.NextShot(tracking)
+	}
+}
+
+func TestProbabilityStrategyLeavesOutSquaresTouchingASunkShip(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(MorskoiBoiRules)
+	board, _ = PlaceShip(board, "Boat", 4, 4, Horizontal)
+	board, _, _, _ = shootOpponent(board, 4, 4)
+
+	//Act
+	got := placementDensity(board.TrackingView())
+
+	//Assert
+	for row := 3; row <= 5; row++ {
+		for col := 3; col <= 5; col++ {
+			if got[row][col] != 0 {
+				t.Errorf("%s: got density %d, want 0 next to the sunk Boat", Coord{Row: row, Col: col}, got[row][col])
+			}
+		}
+	}
+	if got[4][2] == 0 {
+		t.Errorf("C5: got density 0, want ships able to lie one square away")
+	}
+}
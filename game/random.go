@@ -0,0 +1,73 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+const maxRandomPlacementAttempts = 100
+
+// PlaceFleetRandomly adds every ship in fleet to board at random. A failed
+// attempt starts again from the original board, so the caller either gets the
+// whole fleet or the board back unchanged.
+func PlaceFleetRandomly(board Board, fleet FleetSpec, rng *rand.Rand) (Board, error) {
+	squaresNeeded := 0
+	for _, shipClass := range fleet {
+		boardClass, known := board.rules.Fleet.class(shipClass.Name)
+		if !known {
+			return board, fmt.Errorf("%w: %q is not in the fleet", ErrUnknownShipClass, shipClass.Name)
+		}
+		placed := countOfShipsOfClass(board, shipClass.Name)
+		if placed+shipClass.Count > boardClass.Count {
+			return board, &FleetError{Class: shipClass.Name, Placed: placed + shipClass.Count, Count: boardClass.Count}
+		}
+		squaresNeeded += boardClass.Length * shipClass.Count
+	}
+	if squaresNeeded > board.Width()*board.Height() {
+		return board, fmt.Errorf("%w: fleet needs %d squares, board has %d", ErrFleetDoesNotFit, squaresNeeded, board.Width()*board.Height())
+	}
+
+	// Longest ships go first while there is still room for them.
+	classes := append(FleetSpec(nil), fleet...)
+	sort.SliceStable(classes, func(i, j int) bool {
+		return classes[i].Length > classes[j].Length
+	})
+
+	for attempt := 0; attempt < maxRandomPlacementAttempts; attempt++ {
+		placedBoard, placed := placeClassesRandomly(board, classes, rng)
+		if placed {
+			return placedBoard, nil
+		}
+	}
+	return board, fmt.Errorf("%w: no layout found after %d attempts", ErrFleetDoesNotFit, maxRandomPlacementAttempts)
+}
+
+func placeClassesRandomly(board Board, classes FleetSpec, rng *rand.Rand) (Board, bool) {
+	for _, shipClass := range classes {
+		for i := 0; i < shipClass.Count; i++ {
+			candidates := legalPlacements(board, shipClass.Name)
+			if len(candidates) == 0 {
+				return board, false
+			}
+			choice := candidates[rng.Intn(len(candidates))]
+			board, _ = PlaceShip(board, choice.Class, choice.Row, choice.Col, choice.Orientation)
+		}
+	}
+	return board, true
+}
+
+func legalPlacements(board Board, class string) []Ship {
+	var candidates []Ship
+	for row := 0; row < board.Height(); row++ {
+		for col := 0; col < board.Width(); col++ {
+			for _, orientation := range []Orientation{Horizontal, Vertical} {
+				candidate, placeErr := checkPlacement(board, class, row, col, orientation)
+				if placeErr == nil {
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,122 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestPlaceFleetRandomlyPlacesWholeFleet(t *testing.T) {
+	type game struct {
+		name  string
+		rules Rules
+	}
+	games := []game{
+		{name: "default", rules: DefaultRules},
+		{name: "classic", rules: ClassicRules},
+		{name: "tournament", rules: TournamentRules},
+	}
+
+	for _, game := range games {
+		//arrange
+		board, _ := CreateBoard(game.rules)
+
+		//act
+		got, err := PlaceFleetRandomly(board, game.rules.Fleet, rand.New(rand.NewSource(1)))
+
+		//assert
+		if err != nil {
+			t.Fatalf("%s: got %v, want no error", game.name, err)
+		}
+		if fleetErr := CheckFleet(got); fleetErr != nil {
+			t.Errorf("%s: got incomplete fleet: %v", game.name, fleetErr)
+		}
+	}
+}
+
+func TestPlaceFleetRandomlyIsReproducibleFromSeed(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+
+	//Act
+	first, _ := PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(42)))
+	second, _ := PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(42)))
+
+	//Assert
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("got different layouts from the same seed:\n%v\n%v", first.Ships(), second.Ships())
+	}
+}
+
+func TestPlaceFleetRandomlyFailsWhenFleetCannotFit(t *testing.T) {
+	//Arrange
+	rules := Rules{Width: 3, Height: 3, Fleet: FleetSpec{{Name: "Carrier", Length: 5, Count: 1}}}
+	board, _ := CreateBoard(rules)
+
+	//Act
+	got, err := PlaceFleetRandomly(board, rules.Fleet, rand.New(rand.NewSource(1)))
+
+	//Assert
+	if !errors.Is(err, ErrFleetDoesNotFit) {
+		t.Errorf("got %v, want %v", err, ErrFleetDoesNotFit)
+	}
+	if len(got.Ships()) != 0 {
+		t.Errorf("got %d ships left on board, want 0", len(got.Ships()))
+	}
+}
+
+func TestPlaceFleetRandomlyFailsWhenFleetHasTooManySquares(t *testing.T) {
+	//Arrange
+	rules := Rules{Width: 2, Height: 2, Fleet: FleetSpec{{Name: "Boat", Length: 1, Count: 5}}}
+	board, _ := CreateBoard(rules)
+
+	//Act
+	_, got := PlaceFleetRandomly(board, rules.Fleet, rand.New(rand.NewSource(1)))
+
+	//Assert
+	want := errors.New("fleet does not fit on the board: fleet needs 5 squares, board has 4")
+	if got == nil || got.Error() != want.Error() {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlaceFleetRandomlyKeepsShipsAlreadyPlaced(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Carrier", 0, 0, Horizontal)
+	rest := FleetSpec{
+		{Name: "Battleship", Length: 4, Count: 1},
+		{Name: "Cruiser", Length: 3, Count: 1},
+		{Name: "Submarine", Length: 3, Count: 1},
+		{Name: "Destroyer", Length: 2, Count: 1},
+	}
+
+	//Act
+	got, err := PlaceFleetRandomly(board, rest, rand.New(rand.NewSource(7)))
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	if got.Ships()[0] != board.Ships()[0] {
+		t.Errorf("got %+v, want Carrier left where it was", got.Ships()[0])
+	}
+	if fleetErr := CheckFleet(got); fleetErr != nil {
+		t.Errorf("got incomplete fleet: %v", fleetErr)
+	}
+}
+
+func TestPlaceFleetRandomlyRejectsShipsBeyondFleet(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	board, _ = PlaceShip(board, "Carrier", 0, 0, Horizontal)
+
+	//Act
+	_, got := PlaceFleetRandomly(board, ClassicFleet, rand.New(rand.NewSource(1)))
+
+	//Assert
+	if !errors.Is(got, ErrFleetFull) {
+		t.Errorf("got %v, want %v", got, ErrFleetFull)
+	}
+}
@@ -0,0 +1,35 @@
+package game
+
+type Outcome int
+
+const (
+	OutcomeMiss Outcome = iota
+	OutcomeHit
+	OutcomeSunk
+)
+
+func (outcome Outcome) String() string {
+	switch outcome {
+	case OutcomeMiss:
+		return "Miss"
+	case OutcomeHit:
+		return "Hit"
+	case OutcomeSunk:
+		return "Sunk"
+	}
+	return "Unknown"
+}
+
+type ShotResult struct {
+	Row            int
+	Col            int
+	Outcome        Outcome
+	SunkShip       string
+	ShipsRemaining int
+	NextPlayer     int
+	GameOver       bool
+}
+
+func (result ShotResult) Coord() Coord {
+	return Coord{Row: result.Row, Col: result.Col}
+}
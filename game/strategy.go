@@ -0,0 +1,56 @@
+package game
+
+import "math/rand"
+
+// Strategy picks where an automated player fires next. It only ever sees the
+// player's tracking view, so a bot knows exactly what a human would.
+type Strategy interface {
+	NextShot(tracking View) Coord
+}
+
+type RandomStrategy struct {
+	rng *rand.Rand
+}
+
+// NewRandomStrategy fires uniformly at random among the squares not yet shot.
+// The same seed always gives the same shots.
+func NewRandomStrategy(rng *rand.Rand) *RandomStrategy {
+	return &RandomStrategy{rng: rng}
+}
+
+func (strategy *RandomStrategy) NextShot(tracking View) Coord {
+	untargeted := untargetedSquares(tracking)
+	if len(untargeted) == 0 {
+		return Coord{}
+	}
+	return untargeted[strategy.rng.Intn(len(untargeted))]
+}
+
+// NextSalvo asks strategy for shots different squares. Each pick is marked as
+// a miss in a copy of tracking before the next, as its result is not known
+// until the whole salvo is fired.
+func NextSalvo(strategy Strategy, tracking View, shots int) []Coord {
+	pending := tracking.clone()
+	volley := make([]Coord, 0, shots)
+	for len(volley) < shots && len(untargetedSquares(pending)) > 0 {
+		shot := strategy.NextShot(pending)
+		if !pending.onGrid(shot) || pending.At(shot) != CellEmpty {
+			break
+		}
+		pending.cells[shot.Row][shot.Col] = CellMiss
+		volley = append(volley, shot)
+	}
+	return volley
+}
+
+func untargetedSquares(tracking View) []Coord {
+	var squares []Coord
+	for row := 0; row < tracking.height; row++ {
+		for col := 0; col < tracking.width; col++ {
+			if tracking.cells[row][col] == CellEmpty {
+				squares = append(squares, Coord{Row: row, Col: col})
+			}
+		}
+	}
+	return squares
+}
@@ -0,0 +1,68 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomStrategyOnlyFiresAtUntargetedSquares(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(ClassicRules)
+	strategy := NewRandomStrategy(rand.New(rand.NewSource(1)))
+
+	for shot := 0; shot < 100; shot++ {
+		//act
+		view, _ := game.ViewFor(1)
+		coord := strategy.NextShot(view.Tracking)
+
+		//assert
+		if view.Tracking.At(coord) != CellEmpty {
+			t.Fatalf("shot %d: got %s which is already %s, want an untargeted square", shot, coord, view.Tracking.At(coord))
+		}
+		game.CurrentPlayerTakeShot(1, coord.Row, coord.Col)
+		if game.Phase() == PhaseFinished {
+			break
+		}
+		game.CurrentPlayerTakeShot(2, 9, shot%10)
+	}
+}
+
+func TestRandomStrategyIsReproducibleWithASeed(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(ClassicRules)
+	first := NewRandomStrategy(rand.New(rand.NewSource(7)))
+	second := NewRandomStrategy(rand.New(rand.NewSource(7)))
+
+	for shot := 0; shot < 10; shot++ {
+		//act
+		got := first.NextShot(board.TrackingView())
+		want := second.NextShot(board.TrackingView())
+
+		//assert
+		if got != want {
+			t.Errorf("shot %d: got %s, want %s", shot, got, want)
+		}
+	}
+}
+
+func TestNextSalvoPicksDifferentSquares(t *testing.T) {
+	//Arrange
+	board, _ := CreateBoard(Rules{Width: 2, Height: 2, Fleet: DefaultFleet})
+	board, _, _, _ = shootOpponent(board, 0, 0)
+	strategy := NewRandomStrategy(rand.New(rand.NewSource(1)))
+
+	//Act
+	got := NextSalvo(strategy, board.TrackingView(), 5)
+
+	//Assert
+	if len(got) != 3 {
+		t.Fatalf("got %d shots, want the 3 squares left", len(got))
+	}
+	seen := map[Coord]bool{{Row: 0, Col: 0}: true}
+	for _, coord := range got {
+		if seen[coord] {
+			t.Errorf("got %s twice or already shot", coord)
+		}
+		seen[coord] = true
+	}
+}
@@ -0,0 +1,151 @@
+package game
+
+import "sort"
+
+// View is a read-only picture of a board as one player is allowed to see it.
+type View struct {
+	width      int
+	height     int
+	cells      [][]Cell
+	fleet      FleetSpec
+	sunkShips  []Ship
+	noTouching bool
+}
+
+type PlayerView struct {
+	Player   int
+	Own      View
+	Tracking View
+}
+
+// ViewFor shows a player their own board in full and the opponent's board as
+// shot results only.
+func (game *Game) ViewFor(player int) (PlayerView, error) {
+	playerErr := isValidPlayer(player)
+	if playerErr != nil {
+		return PlayerView{}, playerErr
+	}
+	return PlayerView{
+		Player:   player,
+		Own:      game.boards[player-1].OwnView(),
+		Tracking: game.boards[changePlayer(player)-1].TrackingView(),
+	}, nil
+}
+
+// OwnView shows everything on the board, with sunk ships marked.
+func (board Board) OwnView() View {
+	return newView(board, func(cell Cell) Cell {
+		return cell
+	})
+}
+
+// TrackingView shows only the shots fired at the board. Squares that have not
+// been shot are always empty, whatever is on them.
+func (board Board) TrackingView() View {
+	return newView(board, func(cell Cell) Cell {
+		if cell == CellShip {
+			return CellEmpty
+		}
+		return cell
+	})
+}
+
+func newView(board Board, reveal func(cell Cell) Cell) View {
+	view := View{
+		width:  board.Width(),
+		height: board.Height(),
+		cells:  make([][]Cell, board.Height()),
+		fleet:  append(FleetSpec(nil), board.rules.Fleet...),
+		// Under NoTouching the squares around a sunk ship are known to be
+		// water, so strategies need the rule as well as the shots.
+		noTouching: board.rules.NoTouching,
+	}
+	for row := range board.cells {
+		view.cells[row] = make([]Cell, board.Width())
+		for col, cell := range board.cells[row] {
+			view.cells[row][col] = reveal(cell)
+		}
+	}
+
+	for _, placed := range board.ships {
+		if !placed.Sunk() {
+			continue
+		}
+		view.sunkShips = append(view.sunkShips, placed)
+		for _, square := range placed.squares() {
+			view.cells[square[0]][square[1]] = CellSunk
+		}
+	}
+	// Ships are listed by position so the view says nothing about the order
+	// they were placed in.
+	sort.Slice(view.sunkShips, func(i, j int) bool {
+		if view.sunkShips[i].Row != view.sunkShips[j].Row {
+			return view.sunkShips[i].Row < view.sunkShips[j].Row
+		}
+		return view.sunkShips[i].Col < view.sunkShips[j].Col
+	})
+	return view
+}
+
+func (view View) Width() int {
+	return view.width
+}
+
+func (view View) Height() int {
+	return view.height
+}
+
+// At returns CellEmpty for squares off the grid.
+func (view View) At(coord Coord) Cell {
+	if !view.onGrid(coord) {
+		return CellEmpty
+	}
+	return view.cells[coord.Row][coord.Col]
+}
+
+func (view View) onGrid(coord Coord) bool {
+	return coord.Row >= 0 && coord.Row < view.height && coord.Col >= 0 && coord.Col < view.width
+}
+
+func (view View) Fleet() FleetSpec {
+	return append(FleetSpec(nil), view.fleet...)
+}
+
+func (view View) NoTouching() bool {
+	return view.noTouching
+}
+
+// nextToSunkShip reports whether the rules rule out a ship on coord because
+// it borders a sunk ship.
+func (view View) nextToSunkShip(coord Coord) bool {
+	if !view.noTouching {
+		return false
+	}
+	for row := coord.Row - 1; row <= coord.Row+1; row++ {
+		for col := coord.Col - 1; col <= coord.Col+1; col++ {
+			if view.At(Coord{Row: row, Col: col}) == CellSunk {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (view View) SunkShips() []Ship {
+	return append([]Ship(nil), view.sunkShips...)
+}
+
+func (view View) String() string {
+	return formatGrid(view.width, view.height, func(row int, col int) string {
+		return view.cells[row][col].symbol()
+	})
+}
+
+func (view View) clone() View {
+	cells := make([][]Cell, len(view.cells))
+	for row := range view.cells {
+		cells[row] = append([]Cell(nil), view.cells[row]...)
+	}
+	view.cells = cells
+	return view
+}
@@ -0,0 +1,142 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestViewForShowsOwnBoardWithIncomingShots(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(ClassicRules)
+	game.CurrentPlayerTakeShot(1, 5, 5)
+	game.CurrentPlayerTakeShot(2, 8, 0)
+	game.CurrentPlayerTakeShot(1, 5, 6)
+	game.CurrentPlayerTakeShot(2, 8, 1)
+	game.CurrentPlayerTakeShot(1, 5, 7)
+	game.CurrentPlayerTakeShot(2, 0, 0)
+	game.CurrentPlayerTakeShot(1, 5, 8)
+	game.CurrentPlayerTakeShot(2, 9, 9)
+
+	//Act
+	view, err := game.ViewFor(1)
+
+	//Assert
+	if err != nil {
+		t.Fatalf("got %v, want no error", err)
+	}
+	want := "   A B C D E F G H I J\n" +
+		" 1 X S S S S . . . . .\n" +
+		" 2 . . . . . . . . . .\n" +
+		" 3 S S S S . . . . . .\n" +
+		" 4 . . . . . . . . . .\n" +
+		" 5 S S S . . . . . . .\n" +
+		" 6 . . . . . . . . . .\n" +
+		" 7 S S S . . . . . . .\n" +
+		" 8 . . . . . . . . . .\n" +
+		" 9 # # . . . . . . . .\n" +
+		"10 . . . . . . . . . o\n"
+	if got := view.Own.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestViewForShowsOnlyShotResultsOfOpponent(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(ClassicRules)
+	game.CurrentPlayerTakeShot(1, 8, 0)
+	game.CurrentPlayerTakeShot(2, 5, 5)
+	game.CurrentPlayerTakeShot(1, 8, 1)
+	game.CurrentPlayerTakeShot(2, 5, 6)
+	game.CurrentPlayerTakeShot(1, 2, 2)
+	game.CurrentPlayerTakeShot(2, 5, 7)
+	game.CurrentPlayerTakeShot(1, 3, 3)
+
+	//Act
+	view, _ := game.ViewFor(1)
+
+	//Assert
+	want := "   A B C D E F G H I J\n" +
+		" 1 . . . . . . . . . .\n" +
+		" 2 . . . . . . . . . .\n" +
+		" 3 . . X . . . . . . .\n" +
+		" 4 . . . o . . . . . .\n" +
+		" 5 . . . . . . . . . .\n" +
+		" 6 . . . . . . . . . .\n" +
+		" 7 . . . . . . . . . .\n" +
+		" 8 . . . . . . . . . .\n" +
+		" 9 # # . . . . . . . .\n" +
+		"10 . . . . . . . . . .\n"
+	if got := view.Tracking.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	wantSunk := []Ship{{Class: "Destroyer", Length: 2, Row: 8, Col: 0, Orientation: Horizontal, Hits: 2}}
+	if got := view.Tracking.SunkShips(); !reflect.DeepEqual(got, wantSunk) {
+		t.Errorf("got %+v, want %+v", got, wantSunk)
+	}
+	if got := view.Tracking.Fleet(); !reflect.DeepEqual(got, ClassicFleet) {
+		t.Errorf("got %+v, want %+v", got, ClassicFleet)
+	}
+}
+
+func TestTrackingViewNeverShowsUnhitShips(t *testing.T) {
+	//Arrange
+	rng := rand.New(rand.NewSource(5))
+	board, _ := CreateBoard(TournamentRules)
+	board, _ = PlaceFleetRandomly(board, TournamentFleet, rng)
+	for shot := 0; shot < 120; shot++ {
+		board, _, _, _ = shootOpponent(board, rng.Intn(15), rng.Intn(15))
+	}
+
+	//Act
+	view := board.TrackingView()
+
+	//Assert
+	for row := 0; row < view.Height(); row++ {
+		for col := 0; col < view.Width(); col++ {
+			coord := Coord{Row: row, Col: col}
+			got := view.At(coord)
+			switch board.cells[row][col] {
+			case CellShip, CellEmpty:
+				if got != CellEmpty {
+					t.Errorf("%s: got %v for an unshot square, want %v", coord, got, CellEmpty)
+				}
+			case CellMiss:
+				if got != CellMiss {
+					t.Errorf("%s: got %v, want %v", coord, got, CellMiss)
+				}
+			case CellHit:
+				if got != CellHit && got != CellSunk {
+					t.Errorf("%s: got %v, want %v or %v", coord, got, CellHit, CellSunk)
+				}
+			}
+		}
+	}
+}
+
+func TestViewForRejectsInvalidPlayer(t *testing.T) {
+	//Arrange
+	game := classicGameInBattle(ClassicRules)
+
+	//Act
+	_, got := game.ViewFor(3)
+
+	//Assert
+	if !errors.Is(got, ErrInvalidPlayer) {
+		t.Errorf("got %v, want %v", got, ErrInvalidPlayer)
+	}
+}
+
+func TestViewAtOffGridIsEmpty(t *testing.T) {
+	//Arrange
+	view := CreateGrid().OwnView()
+
+	//Act
+	got := view.At(Coord{Row: -1, Col: 7})
+
+	//Assert
+	if got != CellEmpty {
+		t.Errorf("got %v, want %v", got, CellEmpty)
+	}
+}